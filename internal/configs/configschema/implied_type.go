@@ -59,6 +59,26 @@ func (b *Block) ContainsSensitive() bool {
 	return false
 }
 
+// ContainsComputed returns true if any of the attributes of the receiving
+// block or any of its descendant blocks are computed, meaning the provider
+// can produce a value for them that the config didn't supply.
+func (b *Block) ContainsComputed() bool {
+	for _, attrS := range b.Attributes {
+		if attrS.Computed {
+			return true
+		}
+		if attrS.NestedType != nil && attrS.NestedType.ContainsComputed() {
+			return true
+		}
+	}
+	for _, blockS := range b.BlockTypes {
+		if blockS.ContainsComputed() {
+			return true
+		}
+	}
+	return false
+}
+
 // ContainsWriteOnly returns true if any of the attributes of the receiving
 // block or any of its descendant blocks are considered write only
 // based on the declarations in the schema.
@@ -166,6 +186,21 @@ func (o *Object) ContainsSensitive() bool {
 	return false
 }
 
+// ContainsComputed returns true if any of the attributes of the receiving
+// Object are computed, meaning the provider can produce a value for them
+// that the config didn't supply.
+func (o *Object) ContainsComputed() bool {
+	for _, attrS := range o.Attributes {
+		if attrS.Computed {
+			return true
+		}
+		if attrS.NestedType != nil && attrS.NestedType.ContainsComputed() {
+			return true
+		}
+	}
+	return false
+}
+
 // ContainsWriteOnly returns true if any of the attributes of the receiving
 // Object are considered write only based on the declarations in the schema.
 func (o *Object) ContainsWriteOnly() bool {
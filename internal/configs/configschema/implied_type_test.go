@@ -220,6 +220,84 @@ func TestBlockContainsSensitive(t *testing.T) {
 	}
 }
 
+func TestBlockContainsComputed(t *testing.T) {
+	tests := map[string]struct {
+		Schema *Block
+		Want   bool
+	}{
+		"object contains computed": {
+			&Block{
+				Attributes: map[string]*Attribute{
+					"computed": {Computed: true},
+				},
+			},
+			true,
+		},
+		"no computed attrs": {
+			&Block{
+				Attributes: map[string]*Attribute{
+					"required": {Required: true},
+				},
+			},
+			false,
+		},
+		"nested object contains computed": {
+			&Block{
+				Attributes: map[string]*Attribute{
+					"nested": {
+						NestedType: &Object{
+							Nesting: NestingSingle,
+							Attributes: map[string]*Attribute{
+								"computed": {Computed: true},
+							},
+						},
+					},
+				},
+			},
+			true,
+		},
+		"nested block contains computed": {
+			&Block{
+				BlockTypes: map[string]*NestedBlock{
+					"nested": {
+						Block: Block{
+							Attributes: map[string]*Attribute{
+								"computed": {Computed: true},
+							},
+						},
+						Nesting: NestingSingle,
+					},
+				},
+			},
+			true,
+		},
+		"nested obj, no computed attrs": {
+			&Block{
+				Attributes: map[string]*Attribute{
+					"nested": {
+						NestedType: &Object{
+							Nesting: NestingSingle,
+							Attributes: map[string]*Attribute{
+								"required": {Required: true},
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := test.Schema.ContainsComputed()
+			if got != test.Want {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, test.Want)
+			}
+		})
+	}
+}
+
 func TestBlockContainsWriteOnly(t *testing.T) {
 	tests := map[string]struct {
 		Schema *Block
@@ -527,6 +605,54 @@ func TestObjectContainsSensitive(t *testing.T) {
 
 }
 
+func TestObjectContainsComputed(t *testing.T) {
+	tests := map[string]struct {
+		Schema *Object
+		Want   bool
+	}{
+		"object contains computed": {
+			&Object{
+				Attributes: map[string]*Attribute{
+					"computed": {Computed: true},
+				},
+			},
+			true,
+		},
+		"no computed attrs": {
+			&Object{
+				Attributes: map[string]*Attribute{
+					"required": {Required: true},
+				},
+			},
+			false,
+		},
+		"nested object contains computed": {
+			&Object{
+				Attributes: map[string]*Attribute{
+					"nested": {
+						NestedType: &Object{
+							Nesting: NestingSingle,
+							Attributes: map[string]*Attribute{
+								"computed": {Computed: true},
+							},
+						},
+					},
+				},
+			},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := test.Schema.ContainsComputed()
+			if got != test.Want {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, test.Want)
+			}
+		})
+	}
+}
+
 func TestObjectContainsWriteOnly(t *testing.T) {
 	tests := map[string]struct {
 		Schema *Object
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package configschema
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// OptionalComputedPaths returns a set of paths into the given value that
+// correspond to attributes the schema declares as both Optional and
+// Computed, regardless of whether the value at that path is currently
+// null, unknown, or known. Callers that care about unknown-ness or
+// null-ness can filter the result against the actual value themselves.
+func (b *Block) OptionalComputedPaths(val cty.Value, basePath cty.Path) []cty.Path {
+	var ret []cty.Path
+
+	// a block cannot itself be optional+computed, so nothing to return
+	if val.IsNull() || !val.IsKnown() {
+		return ret
+	}
+
+	for name, attrS := range b.Attributes {
+		attrPath := slices.Concat(basePath, cty.GetAttrPath(name))
+
+		if attrS.Optional && attrS.Computed {
+			ret = append(ret, attrPath)
+		}
+
+		if attrS.NestedType != nil {
+			ret = append(ret, attrS.NestedType.optionalComputedPaths(val.GetAttr(name), attrPath)...)
+		}
+	}
+
+	for name, blockS := range b.BlockTypes {
+		blockV := val.GetAttr(name)
+		if blockV.IsNull() || !blockV.IsKnown() {
+			continue
+		}
+
+		blockPath := slices.Concat(basePath, cty.GetAttrPath(name))
+
+		switch blockS.Nesting {
+		case NestingSingle, NestingGroup:
+			ret = append(ret, blockS.Block.OptionalComputedPaths(blockV, blockPath)...)
+		case NestingList, NestingMap, NestingSet:
+			blockV, _ = blockV.Unmark() // peel off one level of marking so we can iterate
+			for it := blockV.ElementIterator(); it.Next(); {
+				idx, blockEV := it.Element()
+				blockInstancePath := slices.Concat(blockPath, cty.IndexPath(idx))
+				ret = append(ret, blockS.Block.OptionalComputedPaths(blockEV, blockInstancePath)...)
+			}
+		default:
+			panic(fmt.Sprintf("unsupported nesting mode %s", blockS.Nesting))
+		}
+	}
+	return ret
+}
+
+// optionalComputedPaths returns a set of paths into the given value that
+// correspond to attributes the nested object declares as both Optional and
+// Computed.
+func (o *Object) optionalComputedPaths(val cty.Value, basePath cty.Path) []cty.Path {
+	var ret []cty.Path
+
+	if val.IsNull() || !val.IsKnown() {
+		return ret
+	}
+
+	for name, attrS := range o.Attributes {
+		if !attrS.Optional || !attrS.Computed {
+			if attrS.NestedType == nil {
+				continue
+			}
+		}
+
+		switch o.Nesting {
+		case NestingSingle, NestingGroup:
+			attrPath := slices.Concat(basePath, cty.GetAttrPath(name))
+			if attrS.Optional && attrS.Computed {
+				ret = append(ret, attrPath)
+			}
+			if attrS.NestedType != nil {
+				ret = append(ret, attrS.NestedType.optionalComputedPaths(val.GetAttr(name), attrPath)...)
+			}
+		case NestingList, NestingMap, NestingSet:
+			val, _ = val.Unmark() // peel off one level of marking so we can iterate
+			for it := val.ElementIterator(); it.Next(); {
+				idx, attrEV := it.Element()
+				attrV := attrEV.GetAttr(name)
+				attrPath := slices.Concat(basePath, cty.IndexPath(idx).GetAttr(name))
+
+				if attrS.Optional && attrS.Computed {
+					ret = append(ret, attrPath)
+				}
+				if attrS.NestedType != nil {
+					ret = append(ret, attrS.NestedType.optionalComputedPaths(attrV, attrPath)...)
+				}
+			}
+		default:
+			panic(fmt.Sprintf("unsupported nesting mode %s", o.Nesting))
+		}
+	}
+	return ret
+}
@@ -1021,7 +1021,7 @@ func (p *provider) InvokeAction(req *tfplugin5.InvokeAction_Request, server tfpl
 		return invokeResp.Diagnostics.Err()
 	}
 
-	for invokeEvent := range invokeResp.Events {
+	for invokeEvent := range invokeResp.Events.Seq {
 		switch invokeEvt := invokeEvent.(type) {
 		case providers.InvokeActionEvent_Progress:
 			server.Send(&tfplugin5.InvokeAction_Event{
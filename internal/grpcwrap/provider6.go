@@ -1092,7 +1092,7 @@ func (p *provider6) InvokeAction(req *tfplugin6.InvokeAction_Request, server tfp
 		return invokeResp.Diagnostics.Err()
 	}
 
-	for invokeEvent := range invokeResp.Events {
+	for invokeEvent := range invokeResp.Events.Seq {
 		switch invokeEvt := invokeEvent.(type) {
 		case providers.InvokeActionEvent_Progress:
 			server.Send(&tfplugin6.InvokeAction_Event{
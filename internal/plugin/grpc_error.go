@@ -4,6 +4,7 @@
 package plugin
 
 import (
+	"context"
 	"fmt"
 	"path"
 	"runtime"
@@ -18,15 +19,23 @@ import (
 // Since we don't use RPC status errors for the plugin protocol, these do not
 // contain any useful details, and we can return some text that at least
 // indicates the plugin call and possible error condition.
-func grpcErr(err error) (diags tfdiags.Diagnostics) {
+//
+// ctx must be (a descendant of) the context returned by rpcContext for the
+// failed call, so that the request ID rpcContext generated can be included
+// in the returned diagnostic and in the logged error, letting a provider
+// that echoes the same ID back into its own logs be correlated with this
+// failure.
+func grpcErr(ctx context.Context, err error) (diags tfdiags.Diagnostics) {
 	if err == nil {
 		return
 	}
 
+	requestID := requestIDFromContext(ctx)
+
 	// extract the method name from the caller.
 	pc, _, _, ok := runtime.Caller(1)
 	if !ok {
-		logger.Error("unknown grpc call", "error", err)
+		logger.Error("unknown grpc call", "error", err, "request_id", requestID)
 		return diags.Append(err)
 	}
 
@@ -37,7 +46,7 @@ func grpcErr(err error) (diags tfdiags.Diagnostics) {
 	_, requestName := path.Split(f.Name())
 
 	// Here we can at least correlate the error in the logs to a particular binary.
-	logger.Error(requestName, "error", err)
+	logger.Error(requestName, "error", err, "request_id", requestID)
 
 	// TODO: while this expands the error codes into somewhat better messages,
 	// this still does not easily link the error to an actual user-recognizable
@@ -51,27 +60,39 @@ func grpcErr(err error) (diags tfdiags.Diagnostics) {
 		diags = diags.Append(tfdiags.WholeContainingBody(
 			tfdiags.Error,
 			"Plugin did not respond",
-			fmt.Sprintf("The plugin encountered an error, and failed to respond to the %s call. "+
-				"The plugin logs may contain more details.", requestName),
+			withRequestID(fmt.Sprintf("The plugin encountered an error, and failed to respond to the %s call: %v. "+
+				"The plugin logs may contain more details.", requestName, err), requestID),
 		))
 	case codes.Canceled:
 		diags = diags.Append(tfdiags.WholeContainingBody(
 			tfdiags.Error,
 			"Request cancelled",
-			fmt.Sprintf("The %s request was cancelled.", requestName),
+			withRequestID(fmt.Sprintf("The %s request was cancelled.", requestName), requestID),
 		))
 	case codes.Unimplemented:
 		diags = diags.Append(tfdiags.WholeContainingBody(
 			tfdiags.Error,
 			"Unsupported plugin method",
-			fmt.Sprintf("The %s method is not supported by this plugin.", requestName),
+			withRequestID(fmt.Sprintf("The %s method is not supported by this plugin.", requestName), requestID),
 		))
 	default:
 		diags = diags.Append(tfdiags.WholeContainingBody(
 			tfdiags.Error,
 			"Plugin error",
-			fmt.Sprintf("The plugin returned an unexpected error from %s: %v", requestName, err),
+			withRequestID(fmt.Sprintf("The plugin returned an unexpected error from %s: %v", requestName, err), requestID),
 		))
 	}
 	return
 }
+
+// withRequestID appends a parenthetical request ID to a diagnostic detail
+// string, so a user reporting the error can give a provider maintainer
+// something to grep their own logs for. It returns detail unchanged if
+// requestID is empty, which happens when grpcErr is called with a context
+// that didn't come from rpcContext.
+func withRequestID(detail, requestID string) string {
+	if requestID == "" {
+		return detail
+	}
+	return fmt.Sprintf("%s (request ID: %s)", detail, requestID)
+}
@@ -5,30 +5,84 @@ package plugin
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	plugin "github.com/hashicorp/go-plugin"
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/function"
 	ctyjson "github.com/zclconf/go-cty/cty/json"
 	"github.com/zclconf/go-cty/cty/msgpack"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
 
 	"github.com/hashicorp/terraform/internal/addrs"
 	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/lang/format"
+	"github.com/hashicorp/terraform/internal/lang/marks"
 	"github.com/hashicorp/terraform/internal/logging"
 	"github.com/hashicorp/terraform/internal/plugin/convert"
 	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/tfdiags"
 	proto "github.com/hashicorp/terraform/internal/tfplugin5"
 )
 
 var logger = logging.HCLogger()
 
+// ErrUnimplemented is wrapped into the diagnostics returned by optional
+// GRPCProvider methods that a given provider or resource type doesn't
+// support, so callers can use errors.Is to distinguish "not supported" from
+// other kinds of failure.
+var ErrUnimplemented = errors.New("not implemented by this provider")
+
+// Interceptor lets a caller observe and rewrite the proto requests and
+// responses flowing through a GRPCProvider's calls, without forking this
+// package. It's meant for building a caching proxy in front of a provider or
+// a golden-file recorder for its traffic.
+//
+// Only PlanResourceChange invokes the hooks; the other methods send their
+// requests without passing them through an Interceptor.
+//
+// BeforeCall is invoked with the outgoing proto request for method just
+// before it's sent; if it returns non-nil, that value replaces req. AfterCall
+// is invoked with the proto response once the call returns successfully, for
+// observation only, since there's nowhere to route a replacement response
+// back to the in-flight call.
+type Interceptor interface {
+	BeforeCall(method string, req any) any
+	AfterCall(method string, resp any)
+}
+
+// Metrics lets a caller observe the latency and outcome of every call a
+// GRPCProvider makes, without forking this package. It's meant for wiring up
+// a Prometheus or statsd exporter so operators get per-provider-method
+// latency and error-rate visibility.
+//
+// ObserveCall is invoked once the call has returned, with the wall-clock
+// duration of the call and the error it produced, if any. err is nil on
+// success.
+type Metrics interface {
+	ObserveCall(method string, duration time.Duration, err error)
+}
+
 // GRPCProviderPlugin implements plugin.GRPCPlugin for the go-plugin package.
 type GRPCProviderPlugin struct {
 	plugin.Plugin
@@ -38,6 +92,7 @@ type GRPCProviderPlugin struct {
 func (p *GRPCProviderPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
 	return &GRPCProvider{
 		client: proto.NewProviderClient(c),
+		conn:   c,
 		ctx:    ctx,
 	}, nil
 }
@@ -68,37 +123,499 @@ type GRPCProvider struct {
 	// Proto client use to make the grpc service calls.
 	client proto.ProviderClient
 
+	// conn is the underlying grpc connection backing client, kept only for
+	// observability (see ConnState). Its lifecycle is owned by go-plugin, or
+	// by TestServer in end to end tests; Close never closes it directly.
+	conn *grpc.ClientConn
+
 	// this context is created by the plugin package, and is canceled when the
 	// plugin process ends.
 	ctx context.Context
 
+	// TraceContext, when set, carries an OpenTelemetry span that outgoing
+	// provider RPCs should be correlated with. If set, its span context is
+	// injected into the outgoing gRPC metadata of every call, so that
+	// provider SDKs that read it can attach their own spans as children of
+	// core's. It plays no role in plugin process lifetime, unlike ctx.
+	TraceContext context.Context
+
+	// Now, when set, is used in place of time.Now wherever this provider
+	// computes a renewal deadline relative to the current time, such as
+	// flagging an ephemeral resource's RenewAt as already elapsed. It exists
+	// so tests can inject a fake clock instead of racing the real one; a nil
+	// Now behaves exactly like time.Now.
+	Now func() time.Time
+
+	// EnforceTimeouts opts this provider into deriving a context.WithTimeout
+	// for PlanResourceChange, ApplyResourceChange, and ReadResource from the
+	// resource's configured "timeouts" block, where the resource schema
+	// declares one and the config sets a value for the operation being
+	// performed. It's off by default so existing callers aren't surprised by
+	// a call that used to wait indefinitely now failing on a slow backend.
+	EnforceTimeouts bool
+
+	// Interceptor, when set, is given the chance to observe and rewrite the
+	// proto request/response of PlanResourceChange calls; no other method
+	// invokes it. It's nil by default, which costs a nil check per call and
+	// nothing else.
+	Interceptor Interceptor
+
+	// Metrics, when set, is given the latency and outcome of every call this
+	// provider makes. It's nil by default, which costs a nil check per call
+	// and nothing else: observeCall returns a shared no-op closure rather
+	// than allocating one.
+	Metrics Metrics
+
+	// MaxSendMsgSize, when non-zero, bounds the size in bytes of outgoing
+	// messages for calls that can carry large payloads to the provider, such
+	// as ApplyResourceChange with a large planned state. It's applied via
+	// grpc.MaxSendMsgSizeCallOption. A zero value leaves gRPC's own default
+	// in effect.
+	MaxSendMsgSize int
+
+	// TypeAliases, when set, maps a resource type name a caller might still
+	// use (for example because a provider renamed a resource type) to the
+	// resource type name the provider's current schema actually advertises.
+	// Resource type lookups and the TypeName sent to the provider both
+	// resolve through it via resolveResourceTypeName, so a request for the
+	// old name keeps working against the new schema. It's nil by default,
+	// which makes resolution a no-op.
+	TypeAliases map[string]string
+
 	// schema stores the schema for this provider. This is used to properly
 	// serialize the requests for schemas.
-	mu     sync.Mutex
-	schema providers.GetProviderSchemaResponse
+	mu sync.Mutex
+	// schemaLoaded records whether schema has been populated by a prior
+	// GetProviderSchema round trip or an explicit SetSchema call. A caller
+	// such as SetSchema can legitimately populate a partial schema (for
+	// example, one with no Provider.Body), so schemaLoaded is tracked
+	// separately rather than inferred from any one field of schema being
+	// non-nil.
+	schemaLoaded bool
+	schema       providers.GetProviderSchemaResponse
+
+	// DataSourceCache, when non-nil, memoizes ReadDataSource responses for
+	// the lifetime of this provider instance, keyed by a hash of the
+	// request's TypeName, Config, and ProviderMeta. This is opt-in via
+	// NewDataSourceCache, because some data sources are expected to be
+	// re-read even when their config hasn't changed.
+	DataSourceCache *DataSourceCache
+
+	// FunctionCache, when non-nil, memoizes CallFunction results for the
+	// lifetime of this provider instance, keyed by a hash of the function
+	// name and its encoded arguments. Provider functions are required to be
+	// pure, so this is always safe to enable, but it remains opt-in via
+	// NewFunctionCache since it's not worth the memory for functions that
+	// are never called twice with the same arguments.
+	FunctionCache *FunctionCache
+
+	// ValidateProviderConfigCache, when non-nil, memoizes
+	// ValidateProviderConfig responses for the lifetime of this provider
+	// instance, keyed by a hash of the marshaled config. This is opt-in via
+	// NewValidateProviderConfigCache, since a large configuration that
+	// instantiates the same provider config across many modules is the
+	// uncommon case this exists to help with.
+	ValidateProviderConfigCache *ValidateProviderConfigCache
+
+	// OutgoingEncoding selects the wire encoding used for the primary
+	// config/state DynamicValues this provider sends (the Config,
+	// PriorState, PlannedState, and similar top-level values passed to each
+	// RPC). It defaults to EncodingMsgpack, which is more compact and
+	// faster to encode/decode than EncodingJSON; JSON exists for interop
+	// with tooling that inspects the wire and for working around msgpack
+	// quirks in a particular provider. Secondary values, such as resource
+	// identity, provider_meta, and function call arguments, are always
+	// encoded as msgpack. Incoming DynamicValues are unaffected, since
+	// decodeDynamicValue already accepts either encoding.
+	OutgoingEncoding Encoding
+
+	// CancelInflightOnStop opts this provider into calling CancelInflight
+	// once Stop has sent the plugin its stop signal. It's off by default
+	// because Stop already asks a well-behaved provider to wind down on its
+	// own; this exists for callers, such as Ctrl-C handling, that also want
+	// any calls still in flight to abort immediately rather than wait for
+	// the provider to notice the stop signal.
+	CancelInflightOnStop bool
+
+	// SkipNoopPlans opts this provider into a client-side short-circuit in
+	// PlanResourceChange: when the proposed new state is RawEquals to the
+	// prior state and the resource's schema has no computed attributes that
+	// a provider could still change, PlanResourceChange returns the prior
+	// state as the planned state without making an RPC at all. It's off by
+	// default because it changes observable behavior for providers that plan
+	// a change even when nothing in the public schema would differ, such as
+	// one emitting a warning diagnostic on every plan.
+	SkipNoopPlans bool
+
+	// StrictConformance opts this provider into running a full conformance
+	// check on every decoded NewState, PlannedState, State, and identity
+	// value against its schema's implied type, appending a diagnostic that
+	// pinpoints the offending path on any mismatch. It's off by default
+	// since the check has a real cost and a provider returning malformed
+	// values is already a bug the normal decode path will usually surface
+	// some other way; this exists as a debugging aid for provider
+	// developers who want to catch schema/value drift immediately.
+	StrictConformance bool
+
+	// ContinueOnSchemaError opts this provider into attempting an RPC even
+	// when its own GetProviderSchema call already came back with errors,
+	// instead of short-circuiting immediately with those same diagnostics.
+	// The schema's errors are still recorded on the response, but the call
+	// proceeds and fails gracefully wherever it next needs data the broken
+	// schema didn't provide (an unknown resource type, for instance), rather
+	// than refusing outright. It exists for provider test suites and other
+	// diagnostics-collection tooling that wants to probe as much provider
+	// behavior as possible under partial-schema conditions and gather every
+	// error in one pass.
+	//
+	// This is unsafe for production use: Terraform's normal CRUD paths
+	// assume a clean schema and are not hardened against acting on a
+	// partially-broken one.
+	ContinueOnSchemaError bool
+
+	// sawLegacyTypeSystem latches to true the first time any
+	// PlanResourceChange or ApplyResourceChange response reports
+	// LegacyTypeSystem, so callers can tell, across the lifetime of this
+	// provider instance, whether they need to be lenient with it the way
+	// the legacy SDK's imprecise type mapping requires. It only ever
+	// transitions false -> true, never back, since a provider doesn't stop
+	// being on the legacy SDK partway through a run. Use
+	// SawLegacyTypeSystem to read it.
+	sawLegacyTypeSystem atomic.Bool
+
+	// ConcurrencyLimits, when set, bounds how many simultaneous ReadResource,
+	// PlanResourceChange, and ApplyResourceChange calls are in flight at
+	// once for a given resource type, keyed by TypeName. A type with no
+	// entry, or an entry <= 0, is unlimited. This exists for providers
+	// fronting cloud APIs with a hard per-endpoint rate limit, where
+	// Terraform's own parallelism would otherwise trip it. It must be set
+	// before the provider is used and not mutated afterwards, since it's
+	// read without a lock.
+	ConcurrencyLimits map[string]int
+
+	// concurrencySemsMu guards concurrencySems.
+	concurrencySemsMu sync.Mutex
+	concurrencySems   map[string]chan struct{}
+
+	// inflightMu guards inflightCtx and inflightCancel.
+	inflightMu     sync.Mutex
+	inflightCtx    context.Context
+	inflightCancel context.CancelFunc
+}
+
+// Encoding selects the wire encoding GRPCProvider uses for outgoing
+// DynamicValues.
+type Encoding int
+
+const (
+	// EncodingMsgpack encodes outgoing DynamicValues as MessagePack. This is
+	// the default, and the more compact and performant of the two.
+	EncodingMsgpack Encoding = iota
+
+	// EncodingJSON encodes outgoing DynamicValues as JSON instead of
+	// MessagePack. JSON has no representation for cty value refinements, so
+	// choosing it loses any refinements (such as a known string prefix) on
+	// an outgoing unknown value.
+	EncodingJSON
+)
+
+// encodeDynamicValue marshals v against ty using p.OutgoingEncoding, and
+// returns it already wrapped in the proto.DynamicValue envelope callers send
+// on the wire.
+func (p *GRPCProvider) encodeDynamicValue(v cty.Value, ty cty.Type) (*proto.DynamicValue, error) {
+	if p.OutgoingEncoding == EncodingJSON {
+		j, err := ctyjson.Marshal(v, ty)
+		if err != nil {
+			return nil, err
+		}
+		return &proto.DynamicValue{Json: j}, nil
+	}
+
+	mp, err := msgpack.Marshal(v, ty)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.DynamicValue{Msgpack: mp}, nil
+}
+
+// dynamicValueBufferPool holds reusable byte slices for outgoing MessagePack
+// DynamicValue payloads. Resource CRUD calls run once per resource instance,
+// so a plan or apply touching thousands of resources would otherwise churn
+// through thousands of short-lived allocations for values that are only
+// ever read once, by gRPC marshaling the request onto the wire.
+var dynamicValueBufferPool = sync.Pool{
+	New: func() any {
+		buf := make([]byte, 0, 512)
+		return &buf
+	},
+}
+
+// encodeDynamicValuePooled is like encodeDynamicValue, but for the
+// MessagePack encoding it copies go-cty's freshly allocated result into a
+// buffer borrowed from dynamicValueBufferPool rather than returning that
+// allocation directly. The returned release func returns the buffer to the
+// pool and must be called once the caller is done with the *proto.DynamicValue
+// -- in practice, right after the gRPC call that sends it returns, since the
+// gRPC layer copies the bytes onto the wire before that call completes.
+func (p *GRPCProvider) encodeDynamicValuePooled(v cty.Value, ty cty.Type) (*proto.DynamicValue, func(), error) {
+	if p.OutgoingEncoding == EncodingJSON {
+		dv, err := p.encodeDynamicValue(v, ty)
+		return dv, func() {}, err
+	}
+
+	mp, err := msgpack.Marshal(v, ty)
+	if err != nil {
+		return nil, func() {}, err
+	}
+
+	bufPtr := dynamicValueBufferPool.Get().(*[]byte)
+	*bufPtr = append((*bufPtr)[:0], mp...)
+	release := func() {
+		dynamicValueBufferPool.Put(bufPtr)
+	}
+	return &proto.DynamicValue{Msgpack: *bufPtr}, release, nil
+}
+
+// FunctionCache memoizes CallFunction results keyed by a hash of the
+// function name and its arguments. It is safe for concurrent use.
+type FunctionCache struct {
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]providers.CallFunctionResponse
+}
+
+// NewFunctionCache returns an empty FunctionCache ready to be assigned to
+// GRPCProvider.FunctionCache to enable CallFunction memoization.
+func NewFunctionCache() *FunctionCache {
+	return &FunctionCache{
+		entries: make(map[[sha256.Size]byte]providers.CallFunctionResponse),
+	}
+}
+
+func (c *FunctionCache) get(key [sha256.Size]byte) (providers.CallFunctionResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	resp, ok := c.entries[key]
+	return resp, ok
+}
+
+func (c *FunctionCache) set(key [sha256.Size]byte, resp providers.CallFunctionResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = resp
+}
+
+// clear removes all memoized results, invalidating the cache.
+func (c *FunctionCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[[sha256.Size]byte]providers.CallFunctionResponse)
+}
+
+// DataSourceCache memoizes ReadDataSource responses keyed by a hash of the
+// request that produced them. It is safe for concurrent use.
+type DataSourceCache struct {
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]providers.ReadDataSourceResponse
+}
+
+// NewDataSourceCache returns an empty DataSourceCache ready to be assigned to
+// GRPCProvider.DataSourceCache to enable ReadDataSource memoization.
+func NewDataSourceCache() *DataSourceCache {
+	return &DataSourceCache{
+		entries: make(map[[sha256.Size]byte]providers.ReadDataSourceResponse),
+	}
+}
+
+func (c *DataSourceCache) get(key [sha256.Size]byte) (providers.ReadDataSourceResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	resp, ok := c.entries[key]
+	return resp, ok
+}
+
+func (c *DataSourceCache) set(key [sha256.Size]byte, resp providers.ReadDataSourceResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = resp
+}
+
+// clear removes all memoized responses, invalidating the cache.
+func (c *DataSourceCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[[sha256.Size]byte]providers.ReadDataSourceResponse)
+}
+
+// readDataSourceRetryMaxAttempts and readDataSourceRetryInterval bound how
+// long ReadDataSource will keep re-reading a data source whose result
+// doesn't yet satisfy providers.ReadDataSourceRequest.RetryUntil.
+const readDataSourceRetryMaxAttempts = 5
+
+var readDataSourceRetryInterval = 2 * time.Second
+
+// readDataSourceCacheKey hashes the parts of a ReadDataSource request that
+// determine its result, so that repeated reads with identical inputs can
+// share a cached response.
+func readDataSourceCacheKey(typeName string, config, providerMeta []byte) [sha256.Size]byte {
+	h := sha256.New()
+	h.Write([]byte(typeName))
+	h.Write(config)
+	h.Write(providerMeta)
+	var sum [sha256.Size]byte
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
+
+// ValidateProviderConfigCache memoizes ValidateProviderConfig responses
+// keyed by a hash of the marshaled config that produced them. It is safe for
+// concurrent use.
+type ValidateProviderConfigCache struct {
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]providers.ValidateProviderConfigResponse
+}
+
+// NewValidateProviderConfigCache returns an empty ValidateProviderConfigCache
+// ready to be assigned to GRPCProvider.ValidateProviderConfigCache to enable
+// ValidateProviderConfig memoization.
+func NewValidateProviderConfigCache() *ValidateProviderConfigCache {
+	return &ValidateProviderConfigCache{
+		entries: make(map[[sha256.Size]byte]providers.ValidateProviderConfigResponse),
+	}
+}
+
+func (c *ValidateProviderConfigCache) get(key [sha256.Size]byte) (providers.ValidateProviderConfigResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	resp, ok := c.entries[key]
+	return resp, ok
+}
+
+func (c *ValidateProviderConfigCache) set(key [sha256.Size]byte, resp providers.ValidateProviderConfigResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = resp
+}
+
+// clear removes all memoized responses, invalidating the cache.
+func (c *ValidateProviderConfigCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[[sha256.Size]byte]providers.ValidateProviderConfigResponse)
+}
+
+// SetSchema pre-populates this provider's local schema cache with an
+// already-known schema, so that GetProviderSchema and the Validate* methods
+// that depend on it return directly instead of performing a GetSchema round
+// trip. This is useful for unit tests of the translation layer and for
+// reattach scenarios where the schema is known ahead of time.
+//
+// Unless force is true, SetSchema has no effect if a schema has already been
+// fetched or set, so that an explicit call from a test or reattach config
+// can't accidentally clobber a schema obtained from a live round trip.
+func (p *GRPCProvider) SetSchema(schema providers.GetProviderSchemaResponse, force bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.schemaLoaded && !force {
+		return
+	}
+	p.schema = schema
+	p.schemaLoaded = true
+}
+
+// InvalidateSchema clears this provider's local schema cache along with its
+// entry in the global providers.SchemaCache, so that the next
+// GetProviderSchema call performs a fresh GetSchema round trip instead of
+// serving what may now be a stale schema.
+//
+// This exists for provider development loops where a provider reattached to
+// a running Terraform process gets rebuilt and restarted with a changed
+// schema: without a way to invalidate the cache, Terraform would keep
+// serving the schema it fetched on first contact for the rest of the run.
+func (p *GRPCProvider) InvalidateSchema() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.schema = providers.GetProviderSchemaResponse{}
+	p.schemaLoaded = false
+	if !p.Addr.IsZero() {
+		providers.SchemaCache.Remove(p.Addr)
+	}
+}
+
+// resourceSchemaConversionConcurrency bounds how many resource-type schema
+// conversions convertResourceSchemas runs at once. Conversion is CPU-bound
+// pointer-chasing with no I/O, so there's nothing to gain from more workers
+// than there are CPUs to run them on.
+var resourceSchemaConversionConcurrency = runtime.GOMAXPROCS(0)
+
+// convertResourceSchemas converts every entry in resourceSchemas (and its
+// corresponding identity schema, if any) from proto to providers.Schema,
+// spreading the work across a bounded pool of goroutines. For a provider
+// with hundreds of resource types, this loop is the dominant cost of
+// GetProviderSchema; convert.ProtoToProviderSchema has no shared mutable
+// state, so running it concurrently is safe, and writing each result into
+// its own map entry keeps the result deterministic regardless of which
+// goroutine finishes first.
+func convertResourceSchemas(resourceSchemas map[string]*proto.Schema, identitySchemas map[string]*proto.ResourceIdentitySchema) map[string]providers.Schema {
+	result := make(map[string]providers.Schema, len(resourceSchemas))
+	if len(resourceSchemas) == 0 {
+		return result
+	}
+
+	type converted struct {
+		name   string
+		schema providers.Schema
+	}
+
+	out := make(chan converted, len(resourceSchemas))
+	sem := make(chan struct{}, resourceSchemaConversionConcurrency)
+	var wg sync.WaitGroup
+	for name, res := range resourceSchemas {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(name string, res *proto.Schema) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			id := identitySchemas[name] // We're fine if the id is not found
+			out <- converted{name: name, schema: convert.ProtoToProviderSchema(res, id)}
+		}(name, res)
+	}
+	wg.Wait()
+	close(out)
+
+	for c := range out {
+		result[c.name] = c.schema
+	}
+	return result
 }
 
-func (p *GRPCProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
+// GetProviderSchema fetches the provider's full schema. It uses
+// p.rpcContext(), so a provider generating a schema large enough to take a
+// while to transfer doesn't hang the call indefinitely: cancelling the
+// inflight context via CancelInflight aborts the in-progress receive rather
+// than waiting for the rest of the response to arrive.
+func (p *GRPCProvider) GetProviderSchema() (resp providers.GetProviderSchemaResponse) {
 	logger.Trace("GRPCProvider: GetProviderSchema")
+	observe := p.observeCall("GetProviderSchema")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("GetProviderSchema", &resp.Diagnostics)
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
 	// check the global cache if we can
 	if !p.Addr.IsZero() {
-		if resp, ok := providers.SchemaCache.Get(p.Addr); ok && resp.ServerCapabilities.GetProviderSchemaOptional {
+		if cached, ok := providers.SchemaCache.Get(p.Addr); ok && cached.ServerCapabilities.GetProviderSchemaOptional {
 			logger.Trace("GRPCProvider: returning cached schema", p.Addr.String())
-			return resp
+			return cached
 		}
 	}
 
-	// If the local cache is non-zero, we know this instance has called
-	// GetProviderSchema at least once and we can return early.
-	if p.schema.Provider.Body != nil {
+	// If the local cache has already been populated, we know this instance
+	// has called GetProviderSchema at least once (or had SetSchema called
+	// on it) and we can return early.
+	if p.schemaLoaded {
 		return p.schema
 	}
 
-	var resp providers.GetProviderSchemaResponse
-
 	resp.ResourceTypes = make(map[string]providers.Schema)
 	resp.DataSources = make(map[string]providers.Schema)
 	resp.EphemeralResourceTypes = make(map[string]providers.Schema)
@@ -114,9 +631,20 @@ func (p *GRPCProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
 	// size much higher on the server side, which is the supported method for
 	// determining payload size.
 	const maxRecvSize = 64 << 20
-	protoResp, err := p.client.GetSchema(p.ctx, new(proto.GetProviderSchema_Request), grpc.MaxRecvMsgSizeCallOption{MaxRecvMsgSize: maxRecvSize})
+	ctx := p.rpcContext()
+	protoResp, err := p.client.GetSchema(ctx, new(proto.GetProviderSchema_Request), grpc.MaxRecvMsgSizeCallOption{MaxRecvMsgSize: maxRecvSize})
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		if status.Code(err) == codes.ResourceExhausted {
+			resp.Diagnostics = resp.Diagnostics.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Provider schema too large",
+				fmt.Sprintf("The schema for provider %q is larger than the %d byte limit Terraform negotiates for this call. "+
+					"This is usually caused by a provider with an extremely large number of resource types; it is not something that can be worked around from the configuration.",
+					p.Addr, maxRecvSize),
+			))
+			return resp
+		}
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 
@@ -131,7 +659,8 @@ func (p *GRPCProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
 		return resp
 	}
 
-	identResp, err := p.client.GetResourceIdentitySchemas(p.ctx, new(proto.GetResourceIdentitySchemas_Request))
+	identCtx := p.rpcContext()
+	identResp, err := p.client.GetResourceIdentitySchemas(identCtx, new(proto.GetResourceIdentitySchemas_Request))
 	if err != nil {
 		if status.Code(err) == codes.Unimplemented {
 			// We don't treat this as an error if older providers don't implement this method,
@@ -140,15 +669,29 @@ func (p *GRPCProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
 				IdentitySchemas: map[string]*proto.ResourceIdentitySchema{},
 			}
 		} else {
-			resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+			resp.Diagnostics = resp.Diagnostics.Append(grpcErr(identCtx, err))
 			return resp
 		}
 	}
 
-	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(identResp.Diagnostics))
-	if resp.Diagnostics.HasErrors() {
-		return resp
+	// A failure fetching identity schemas for some resource types shouldn't
+	// block the rest of the schema from being built, so these diagnostics
+	// are still appended and fall through to building resource schemas
+	// without identity for whichever types didn't make it into
+	// identResp.IdentitySchemas. When the provider still returned at least
+	// one identity schema the failure is partial, and its errors are
+	// downgraded to warnings; when none came back, errors keep their
+	// severity.
+	identDiags := convert.ProtoToDiagnostics(identResp.Diagnostics)
+	if len(identResp.IdentitySchemas) > 0 {
+		for i, diag := range identDiags {
+			if diag.Severity() == tfdiags.Error {
+				desc := diag.Description()
+				identDiags[i] = tfdiags.Sourceless(tfdiags.Warning, desc.Summary, desc.Detail)
+			}
+		}
 	}
+	resp.Diagnostics = resp.Diagnostics.Append(identDiags)
 
 	resp.Provider = convert.ProtoToProviderSchema(protoResp.Provider, nil)
 	if protoResp.ProviderMeta == nil {
@@ -157,9 +700,8 @@ func (p *GRPCProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
 		resp.ProviderMeta = convert.ProtoToProviderSchema(protoResp.ProviderMeta, nil)
 	}
 
-	for name, res := range protoResp.ResourceSchemas {
-		id := identResp.IdentitySchemas[name] // We're fine if the id is not found
-		resp.ResourceTypes[name] = convert.ProtoToProviderSchema(res, id)
+	for name, schema := range convertResourceSchemas(protoResp.ResourceSchemas, identResp.IdentitySchemas) {
+		resp.ResourceTypes[name] = schema
 	}
 
 	for name, data := range protoResp.DataSourceSchemas {
@@ -208,6 +750,17 @@ func (p *GRPCProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
 		resp.ServerCapabilities.MoveResourceState = protoResp.ServerCapabilities.MoveResourceState
 	}
 
+	resp.Diagnostics = resp.Diagnostics.Append(p.detectDuplicateTypeNames(resp))
+
+	// GetProviderSchema.Response has no field for a self-reported provider
+	// status, so resp.ProviderStatus stays nil until the protocol grows one;
+	// only in-process (non-wire) provider implementations can populate it.
+
+	// GetProviderSchema.Response also has no field for a minimum required
+	// Terraform version, so resp.MinTerraformVersion stays empty until the
+	// protocol grows one; only in-process (non-wire) provider
+	// implementations can populate it.
+
 	// set the global cache if we can
 	if !p.Addr.IsZero() {
 		providers.SchemaCache.Set(p.Addr, resp)
@@ -216,25 +769,283 @@ func (p *GRPCProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
 	// always store this here in the client for providers that are not able to
 	// use GetProviderSchemaOptional
 	p.schema = resp
+	p.schemaLoaded = true
+
+	return resp
+}
+
+// GetProviderSchemaFor returns the provider's schema pruned down to only the
+// requested categories, to reduce the size of the struct that callers who
+// only care about part of the schema need to carry around.
+//
+// The current implementation still performs the same full GetSchema round
+// trip as GetProviderSchema (and shares its cache), then discards the
+// categories that weren't asked for; it exists mainly to give core a stable
+// entry point to adopt now, so that a future provider protocol capable of
+// fetching schema by category can be wired in underneath it without callers
+// changing.
+func (p *GRPCProvider) GetProviderSchemaFor(categories providers.SchemaCategories) providers.GetProviderSchemaResponse {
+	resp := p.GetProviderSchema()
+	if resp.Diagnostics.HasErrors() {
+		return resp
+	}
+
+	if categories&providers.SchemaResources == 0 {
+		resp.ResourceTypes = nil
+	}
+	if categories&providers.SchemaDataSources == 0 {
+		resp.DataSources = nil
+	}
+	if categories&providers.SchemaEphemeralResources == 0 {
+		resp.EphemeralResourceTypes = nil
+	}
+	if categories&providers.SchemaListResources == 0 {
+		resp.ListResourceTypes = nil
+	}
+	if categories&providers.SchemaStateStores == 0 {
+		resp.StateStores = nil
+	}
+	if categories&providers.SchemaActions == 0 {
+		resp.Actions = nil
+	}
+	if categories&providers.SchemaFunctions == 0 {
+		resp.Functions = nil
+	}
 
 	return resp
 }
 
+// GetProviderSchemaStream is meant to fetch the provider's schema as a
+// sequence of chunks for providers whose schema approaches the 64MB limit
+// enforced by GetProviderSchema, reassembling the chunks into a single
+// response once received.
+//
+// The tfplugin5 protocol doesn't define a chunked/streaming variant of
+// GetSchema, and there's no server capability a provider could advertise to
+// opt into one, so there's currently nothing for this method to do other
+// than fall back to the single-shot GetProviderSchema. It exists as the
+// stable entry point for callers to adopt now, so that a future protocol
+// version adding a real streaming RPC only needs a change here.
+func (p *GRPCProvider) GetProviderSchemaStream() providers.GetProviderSchemaResponse {
+	return p.GetProviderSchema()
+}
+
+// SupportedOptionalRPCs returns the names of the optional provider RPCs that
+// this provider appears to implement, inferred from the schema it returned
+// from GetProviderSchema. Callers can use this to decide whether it's worth
+// attempting a call like ListResource or PlanAction, without first paying
+// for a round trip that just comes back Unimplemented.
+func (p *GRPCProvider) SupportedOptionalRPCs() []string {
+	schema := p.GetProviderSchema()
+
+	var supported []string
+	if len(schema.ListResourceTypes) > 0 {
+		supported = append(supported, "ListResource")
+	}
+	if len(schema.Actions) > 0 {
+		supported = append(supported, "PlanAction", "InvokeAction", "ValidateActionConfig")
+	}
+	if len(schema.EphemeralResourceTypes) > 0 {
+		supported = append(supported, "ValidateEphemeralResourceConfig", "OpenEphemeralResource", "RenewEphemeralResource", "CloseEphemeralResource")
+	}
+	for _, resSchema := range schema.ResourceTypes {
+		if resSchema.Identity != nil {
+			supported = append(supported, "UpgradeResourceIdentity")
+			break
+		}
+	}
+	if schema.ServerCapabilities.MoveResourceState {
+		supported = append(supported, "MoveResourceState")
+	}
+
+	return supported
+}
+
+// IdentityCapableTypes returns the sorted names of the resource types in
+// this provider's schema that have an identity schema, for callers that
+// need to know up front which resource types support import-by-identity.
+func (p *GRPCProvider) IdentityCapableTypes() ([]string, tfdiags.Diagnostics) {
+	schema := p.GetProviderSchema()
+	if schema.Diagnostics.HasErrors() {
+		return nil, schema.Diagnostics
+	}
+
+	var names []string
+	for name, resSchema := range schema.ResourceTypes {
+		if resSchema.Identity != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names, schema.Diagnostics
+}
+
+// ValidateSchema fetches this provider's schema and runs
+// configschema.Block.InternalValidate over every block in it (resource
+// types, data sources, ephemeral resource types, list resource types, and
+// actions), to catch structural mistakes like an
+// attribute that's both Required and Computed before they reach a user as a
+// confusing downstream failure. It's meant for provider developers to call
+// from their own test suites, not for use during normal Terraform runs.
+func (p *GRPCProvider) ValidateSchema() tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	schema := p.GetProviderSchema()
+	diags = diags.Append(schema.Diagnostics)
+	if schema.Diagnostics.HasErrors() && !p.ContinueOnSchemaError {
+		return diags
+	}
+
+	validateBlock := func(kind, typeName string, block *configschema.Block) {
+		if err := block.InternalValidate(); err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				fmt.Sprintf("Invalid schema for %s %q", kind, typeName),
+				err.Error(),
+			))
+		}
+	}
+
+	for _, typeName := range sortedSchemaKeys(schema.ResourceTypes) {
+		validateBlock("resource type", typeName, schema.ResourceTypes[typeName].Body)
+	}
+	for _, typeName := range sortedSchemaKeys(schema.DataSources) {
+		validateBlock("data source", typeName, schema.DataSources[typeName].Body)
+	}
+	for _, typeName := range sortedSchemaKeys(schema.EphemeralResourceTypes) {
+		validateBlock("ephemeral resource type", typeName, schema.EphemeralResourceTypes[typeName].Body)
+	}
+	for _, typeName := range sortedSchemaKeys(schema.ListResourceTypes) {
+		validateBlock("list resource type", typeName, schema.ListResourceTypes[typeName].Body)
+	}
+	for _, typeName := range sortedActionSchemaKeys(schema.Actions) {
+		validateBlock("action", typeName, schema.Actions[typeName].ConfigSchema)
+	}
+
+	return diags
+}
+
+// sortedSchemaKeys returns the keys of a provider schema map (resource
+// types, data sources, etc.) in a stable, sorted order, so callers that
+// iterate it get deterministic output.
+func sortedSchemaKeys(m map[string]providers.Schema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sortedActionSchemaKeys is sortedSchemaKeys for the Actions map, which has
+// a distinct value type.
+func sortedActionSchemaKeys(m map[string]providers.ActionSchema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// detectDuplicateTypeNames returns a warning diagnostic for every name that
+// appears in more than one of a provider's resource, data source,
+// ephemeral resource, and action schemas. Terraform's configuration syntax
+// distinguishes these by block type, so such an overlap isn't ambiguous to
+// parse, but a provider declaring it is still almost certainly a mistake;
+// catching it here, at schema-load time, surfaces the provider bug well
+// before it causes some more confusing failure downstream.
+//
+// List resource types are deliberately excluded from this check: a list
+// resource type is conventionally given the same name as the resource type
+// it lists, so that overlap is by design rather than a bug.
+func (p *GRPCProvider) detectDuplicateTypeNames(resp providers.GetProviderSchemaResponse) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	kindsByName := make(map[string][]string)
+	for name := range resp.ResourceTypes {
+		kindsByName[name] = append(kindsByName[name], "a resource type")
+	}
+	for name := range resp.DataSources {
+		kindsByName[name] = append(kindsByName[name], "a data source")
+	}
+	for name := range resp.EphemeralResourceTypes {
+		kindsByName[name] = append(kindsByName[name], "an ephemeral resource type")
+	}
+	for name := range resp.Actions {
+		kindsByName[name] = append(kindsByName[name], "an action")
+	}
+
+	names := make([]string, 0, len(kindsByName))
+	for name := range kindsByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		kinds := kindsByName[name]
+		if len(kinds) < 2 {
+			continue
+		}
+		sort.Strings(kinds)
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Warning,
+			fmt.Sprintf("Duplicate type name %q in provider schema", name),
+			fmt.Sprintf("Provider %s declares %q as both %s. This is likely a bug in the provider and should be reported to its developers.",
+				p.Addr, name, strings.Join(kinds, " and ")),
+		))
+	}
+
+	return diags
+}
+
+// Functions returns the provider's full catalog of declared functions,
+// keyed by local function name, so that tooling such as editor integrations
+// and documentation generators can enumerate parameters, variadic info, and
+// return types without fetching and indexing the whole provider schema
+// themselves.
+func (p *GRPCProvider) Functions() (map[string]providers.FunctionDecl, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	schema := p.GetProviderSchema()
+	diags = diags.Append(schema.Diagnostics)
+	if schema.Diagnostics.HasErrors() && !p.ContinueOnSchemaError {
+		return nil, diags
+	}
+
+	return schema.Functions, diags
+}
+
+// MinTerraformVersion returns the minimum Terraform CLI version the
+// provider declared it requires, and whether it declared one at all.
+func (p *GRPCProvider) MinTerraformVersion() (string, bool) {
+	schema := p.GetProviderSchema()
+	if schema.MinTerraformVersion == "" {
+		return "", false
+	}
+	return schema.MinTerraformVersion, true
+}
+
 func (p *GRPCProvider) GetResourceIdentitySchemas() providers.GetResourceIdentitySchemasResponse {
 	logger.Trace("GRPCProvider: GetResourceIdentitySchemas")
 
 	var resp providers.GetResourceIdentitySchemasResponse
+	observe := p.observeCall("GetResourceIdentitySchemas")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("GetResourceIdentitySchemas", &resp.Diagnostics)
 
 	resp.IdentityTypes = make(map[string]providers.IdentitySchema)
 
-	protoResp, err := p.client.GetResourceIdentitySchemas(p.ctx, new(proto.GetResourceIdentitySchemas_Request))
+	ctx := p.rpcContext()
+	protoResp, err := p.client.GetResourceIdentitySchemas(ctx, new(proto.GetResourceIdentitySchemas_Request))
 	if err != nil {
 		if status.Code(err) == codes.Unimplemented {
 			// We expect no error here if older providers don't implement this method
 			return resp
 		}
 
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 
@@ -256,28 +1067,42 @@ func (p *GRPCProvider) GetResourceIdentitySchemas() providers.GetResourceIdentit
 
 func (p *GRPCProvider) ValidateProviderConfig(r providers.ValidateProviderConfigRequest) (resp providers.ValidateProviderConfigResponse) {
 	logger.Trace("GRPCProvider: ValidateProviderConfig")
+	observe := p.observeCall("ValidateProviderConfig")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("ValidateProviderConfig", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
 	ty := schema.Provider.Body.ImpliedType()
 
 	mp, err := msgpack.Marshal(r.Config, ty)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(err)
+		resp.Diagnostics = resp.Diagnostics.Append(scrubSensitiveConfigErr(schema.Provider, r.Config, err))
 		return resp
 	}
 
+	var cacheKey [sha256.Size]byte
+	if p.ValidateProviderConfigCache != nil {
+		cacheKey = sha256.Sum256(mp)
+		if cached, ok := p.ValidateProviderConfigCache.get(cacheKey); ok {
+			return cached
+		}
+	}
+
 	protoReq := &proto.PrepareProviderConfig_Request{
 		Config: &proto.DynamicValue{Msgpack: mp},
 	}
 
-	protoResp, err := p.client.PrepareProviderConfig(p.ctx, protoReq)
+	ctx := p.rpcContext()
+	protoResp, err := p.client.PrepareProviderConfig(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 
@@ -289,39 +1114,62 @@ func (p *GRPCProvider) ValidateProviderConfig(r providers.ValidateProviderConfig
 	resp.PreparedConfig = config
 
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
+
+	if p.ValidateProviderConfigCache != nil && !resp.Diagnostics.HasErrors() {
+		p.ValidateProviderConfigCache.set(cacheKey, resp)
+	}
+
 	return resp
 }
 
+// ValidateResourceConfig sends the given config to the provider for
+// validation. Config values can be unknown with refinements attached (for
+// example an unknown string with a known prefix, produced from a for_each
+// key), and the default msgpack wire encoding preserves those refinements,
+// so a provider that inspects them during validation sees the same
+// refinements the caller attached.
 func (p *GRPCProvider) ValidateResourceConfig(r providers.ValidateResourceConfigRequest) (resp providers.ValidateResourceConfigResponse) {
 	logger.Trace("GRPCProvider: ValidateResourceConfig")
+	observe := p.observeCall("ValidateResourceConfig")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("ValidateResourceConfig", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
-	resourceSchema, ok := schema.ResourceTypes[r.TypeName]
+	typeName := p.resolveResourceTypeName(r.TypeName)
+	resourceSchema, ok := schema.ResourceTypes[typeName]
 	if !ok {
-		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown resource type %q", r.TypeName))
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("resource type", typeName))
+		return resp
+	}
+
+	if schema.ServerCapabilities.SkipValidateResourceConfig {
 		return resp
 	}
 
-	mp, err := msgpack.Marshal(r.Config, resourceSchema.Body.ImpliedType())
+	config := stripWriteOnlyAttrs(resourceSchema, r.Config, r.ClientCapabilities.WriteOnlyAttributesAllowed)
+	configDV, err := p.encodeDynamicValue(config, resourceSchema.Body.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
 
 	protoReq := &proto.ValidateResourceTypeConfig_Request{
-		TypeName:           r.TypeName,
-		Config:             &proto.DynamicValue{Msgpack: mp},
+		TypeName:           typeName,
+		Config:             configDV,
 		ClientCapabilities: clientCapabilitiesToProto(r.ClientCapabilities),
 	}
 
-	protoResp, err := p.client.ValidateResourceTypeConfig(p.ctx, protoReq)
+	ctx := p.rpcContext()
+	protoResp, err := p.client.ValidateResourceTypeConfig(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 
@@ -331,20 +1179,25 @@ func (p *GRPCProvider) ValidateResourceConfig(r providers.ValidateResourceConfig
 
 func (p *GRPCProvider) ValidateDataResourceConfig(r providers.ValidateDataResourceConfigRequest) (resp providers.ValidateDataResourceConfigResponse) {
 	logger.Trace("GRPCProvider: ValidateDataResourceConfig")
+	observe := p.observeCall("ValidateDataResourceConfig")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("ValidateDataResourceConfig", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
 	dataSchema, ok := schema.DataSources[r.TypeName]
 	if !ok {
-		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown data source %q", r.TypeName))
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("data source", r.TypeName))
 		return resp
 	}
 
-	mp, err := msgpack.Marshal(r.Config, dataSchema.Body.ImpliedType())
+	configDV, err := p.encodeDynamicValue(r.Config, dataSchema.Body.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
@@ -352,12 +1205,13 @@ func (p *GRPCProvider) ValidateDataResourceConfig(r providers.ValidateDataResour
 
 	protoReq := &proto.ValidateDataSourceConfig_Request{
 		TypeName: r.TypeName,
-		Config:   &proto.DynamicValue{Msgpack: mp},
+		Config:   configDV,
 	}
 
-	protoResp, err := p.client.ValidateDataSourceConfig(p.ctx, protoReq)
+	ctx := p.rpcContext()
+	protoResp, err := p.client.ValidateDataSourceConfig(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
@@ -366,16 +1220,21 @@ func (p *GRPCProvider) ValidateDataResourceConfig(r providers.ValidateDataResour
 
 func (p *GRPCProvider) ValidateListResourceConfig(r providers.ValidateListResourceConfigRequest) (resp providers.ValidateListResourceConfigResponse) {
 	logger.Trace("GRPCProvider: ValidateListResourceConfig")
+	observe := p.observeCall("ValidateListResourceConfig")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("ValidateListResourceConfig", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
 	listResourceSchema, ok := schema.ListResourceTypes[r.TypeName]
 	if !ok {
-		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown list resource type %q", r.TypeName))
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("list resource type", r.TypeName))
 		return resp
 	}
 
@@ -384,7 +1243,13 @@ func (p *GRPCProvider) ValidateListResourceConfig(r providers.ValidateListResour
 	if r.Config.Type().HasAttribute("config") {
 		config = r.Config.GetAttr("config")
 	}
-	mp, err := msgpack.Marshal(config, configSchema.ImpliedType())
+
+	if err := validateListResourceConfigConformance(r.TypeName, &configSchema.Block, config); err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+
+	configDV, err := p.encodeDynamicValue(config, configSchema.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
@@ -392,12 +1257,13 @@ func (p *GRPCProvider) ValidateListResourceConfig(r providers.ValidateListResour
 
 	protoReq := &proto.ValidateListResourceConfig_Request{
 		TypeName: r.TypeName,
-		Config:   &proto.DynamicValue{Msgpack: mp},
+		Config:   configDV,
 	}
 
-	protoResp, err := p.client.ValidateListResourceConfig(p.ctx, protoReq)
+	ctx := p.rpcContext()
+	protoResp, err := p.client.ValidateListResourceConfig(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 
@@ -407,31 +1273,60 @@ func (p *GRPCProvider) ValidateListResourceConfig(r providers.ValidateListResour
 
 func (p *GRPCProvider) UpgradeResourceState(r providers.UpgradeResourceStateRequest) (resp providers.UpgradeResourceStateResponse) {
 	logger.Trace("GRPCProvider: UpgradeResourceState")
+	observe := p.observeCall("UpgradeResourceState")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("UpgradeResourceState", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
-	resSchema, ok := schema.ResourceTypes[r.TypeName]
+	typeName := p.resolveResourceTypeName(r.TypeName)
+	resSchema, ok := schema.ResourceTypes[typeName]
 	if !ok {
-		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown resource type %q", r.TypeName))
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("resource type", typeName))
+		return resp
+	}
+
+	if len(r.RawStateJSON) == 0 && len(r.RawStateFlatmap) == 0 {
+		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("UpgradeResourceState for %q has nothing to upgrade: both the JSON and flatmap raw state are empty", r.TypeName))
 		return resp
 	}
 
+	if r.Version > resSchema.Version {
+		resp.Diagnostics = resp.Diagnostics.Append(tfdiags.Sourceless(
+			tfdiags.Warning,
+			"Resource schema version downgrade",
+			fmt.Sprintf("The state for %q was created with schema version %d, which is newer than the current schema version %d. Upgrading state to an older schema version can lose data.",
+				r.TypeName, r.Version, resSchema.Version),
+		))
+	}
+
+	rawState := &proto.RawState{}
+	// Only populate the field the caller actually gave us, so we don't send
+	// an empty flatmap (or JSON) to providers that only understand one of
+	// the two raw state encodings.
+	if len(r.RawStateJSON) > 0 {
+		rawState.Json = r.RawStateJSON
+	}
+	if len(r.RawStateFlatmap) > 0 {
+		rawState.Flatmap = r.RawStateFlatmap
+	}
+
 	protoReq := &proto.UpgradeResourceState_Request{
-		TypeName: r.TypeName,
+		TypeName: typeName,
 		Version:  int64(r.Version),
-		RawState: &proto.RawState{
-			Json:    r.RawStateJSON,
-			Flatmap: r.RawStateFlatmap,
-		},
+		RawState: rawState,
 	}
 
-	protoResp, err := p.client.UpgradeResourceState(p.ctx, protoReq)
+	ctx := p.rpcContext()
+	protoResp, err := p.client.UpgradeResourceState(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
@@ -442,7 +1337,7 @@ func (p *GRPCProvider) UpgradeResourceState(r providers.UpgradeResourceStateRequ
 		return resp
 	}
 
-	state, err := decodeDynamicValue(protoResp.UpgradedState, ty)
+	state, err := decodeDynamicValueWithLabel(protoResp.UpgradedState, ty, fmt.Sprintf("decoding upgraded state for %s", r.TypeName))
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
@@ -454,30 +1349,37 @@ func (p *GRPCProvider) UpgradeResourceState(r providers.UpgradeResourceStateRequ
 
 func (p *GRPCProvider) UpgradeResourceIdentity(r providers.UpgradeResourceIdentityRequest) (resp providers.UpgradeResourceIdentityResponse) {
 	logger.Trace("GRPCProvider: UpgradeResourceIdentity")
+	observe := p.observeCall("UpgradeResourceIdentity")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("UpgradeResourceIdentity", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
-	resSchema, ok := schema.ResourceTypes[r.TypeName]
+	typeName := p.resolveResourceTypeName(r.TypeName)
+	resSchema, ok := schema.ResourceTypes[typeName]
 	if !ok {
-		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown resource identity type %q", r.TypeName))
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("resource identity type", typeName))
 		return resp
 	}
 
 	protoReq := &proto.UpgradeResourceIdentity_Request{
-		TypeName: r.TypeName,
+		TypeName: typeName,
 		Version:  int64(r.Version),
 		RawIdentity: &proto.RawState{
 			Json: r.RawIdentityJSON,
 		},
 	}
 
-	protoResp, err := p.client.UpgradeResourceIdentity(p.ctx, protoReq)
+	ctx := p.rpcContext()
+	protoResp, err := p.client.UpgradeResourceIdentity(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
@@ -500,89 +1402,119 @@ func (p *GRPCProvider) UpgradeResourceIdentity(r providers.UpgradeResourceIdenti
 
 func (p *GRPCProvider) ConfigureProvider(r providers.ConfigureProviderRequest) (resp providers.ConfigureProviderResponse) {
 	logger.Trace("GRPCProvider: ConfigureProvider")
+	observe := p.observeCall("ConfigureProvider")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("ConfigureProvider", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
-	var mp []byte
-
 	// we don't have anything to marshal if there's no config
-	mp, err := msgpack.Marshal(r.Config, schema.Provider.Body.ImpliedType())
+	configDV, err := p.encodeDynamicValue(r.Config, schema.Provider.Body.ImpliedType())
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(err)
+		resp.Diagnostics = resp.Diagnostics.Append(scrubSensitiveConfigErr(schema.Provider, r.Config, err))
 		return resp
 	}
 
 	protoReq := &proto.Configure_Request{
-		TerraformVersion: r.TerraformVersion,
-		Config: &proto.DynamicValue{
-			Msgpack: mp,
-		},
+		TerraformVersion:   r.TerraformVersion,
+		Config:             configDV,
 		ClientCapabilities: clientCapabilitiesToProto(r.ClientCapabilities),
 	}
 
-	protoResp, err := p.client.Configure(p.ctx, protoReq)
+	ctx := p.rpcContext()
+	protoResp, err := p.client.Configure(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
+
+	// The wire protocol doesn't yet carry back any informational metadata
+	// the provider resolved while configuring itself (such as a region or
+	// endpoint), nor a list of features the provider could only partially
+	// configure; resp.ConfiguredMeta and resp.UnavailableFeatures are left
+	// nil until Configure.Response grows fields for them.
+
 	return resp
 }
 
 func (p *GRPCProvider) Stop() error {
+	return p.StopDiags().Err()
+}
+
+// StopDiags is the diagnostics-returning equivalent of Stop, for callers
+// that want to present a provider's stop failure as a proper diagnostic
+// rather than a bare error.
+func (p *GRPCProvider) StopDiags() (diags tfdiags.Diagnostics) {
 	logger.Trace("GRPCProvider: Stop")
+	observe := p.observeCall("StopDiags")
+	defer func() { observe(diags.Err()) }()
 
-	resp, err := p.client.Stop(p.ctx, new(proto.Stop_Request))
+	ctx := p.rpcContext()
+	resp, err := p.client.Stop(ctx, new(proto.Stop_Request))
+	if p.CancelInflightOnStop {
+		p.CancelInflight()
+	}
 	if err != nil {
-		return err
+		diags = diags.Append(grpcErr(ctx, err))
+		return diags
 	}
 
 	if resp.Error != "" {
-		return errors.New(resp.Error)
+		diags = diags.Append(errors.New(resp.Error))
 	}
-	return nil
+	return diags
 }
 
 func (p *GRPCProvider) ReadResource(r providers.ReadResourceRequest) (resp providers.ReadResourceResponse) {
 	logger.Trace("GRPCProvider: ReadResource")
+	observe := p.observeCall("ReadResource")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("ReadResource", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
-	resSchema, ok := schema.ResourceTypes[r.TypeName]
+	typeName := p.resolveResourceTypeName(r.TypeName)
+	resSchema, ok := schema.ResourceTypes[typeName]
 	if !ok {
-		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown resource type %s", r.TypeName))
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("resource type", typeName))
 		return resp
 	}
 
 	metaSchema := schema.ProviderMeta
 
-	mp, err := msgpack.Marshal(r.PriorState, resSchema.Body.ImpliedType())
+	currentStateDV, releaseCurrentState, err := p.encodeDynamicValuePooled(r.PriorState, resSchema.Body.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
+	defer releaseCurrentState()
 
 	protoReq := &proto.ReadResource_Request{
-		TypeName:           r.TypeName,
-		CurrentState:       &proto.DynamicValue{Msgpack: mp},
+		TypeName:           typeName,
+		CurrentState:       currentStateDV,
 		Private:            r.Private,
 		ClientCapabilities: clientCapabilitiesToProto(r.ClientCapabilities),
 	}
 
-	if metaSchema.Body != nil {
-		metaMP, err := msgpack.Marshal(r.ProviderMeta, metaSchema.Body.ImpliedType())
-		if err != nil {
-			resp.Diagnostics = resp.Diagnostics.Append(err)
-			return resp
-		}
+	metaMP, err := encodeProviderMeta(metaSchema, r.ProviderMeta)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+	if metaMP != nil {
 		protoReq.ProviderMeta = &proto.DynamicValue{Msgpack: metaMP}
 	}
 
@@ -601,49 +1533,169 @@ func (p *GRPCProvider) ReadResource(r providers.ReadResourceRequest) (resp provi
 		}
 	}
 
-	protoResp, err := p.client.ReadResource(p.ctx, protoReq)
+	// ReadResourceRequest carries only the prior state, not the original
+	// config, so a "timeouts" value set in config but never persisted to
+	// state (an unusual case, since the legacy SDK normally does persist it)
+	// won't be seen here.
+	ctx, limit, enforced, cancel := p.timeoutContext(resSchema, r.PriorState, "read")
+	defer cancel()
+
+	release, err := p.acquireConcurrencySlot(ctx, r.TypeName)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(concurrencyLimitDiags("ReadResource", r.TypeName, err))
+		return resp
+	}
+	defer release()
+
+	protoResp, err := p.client.ReadResource(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		if diags := timeoutDiagnostics("read", limit, enforced, err); diags != nil {
+			resp.Diagnostics = resp.Diagnostics.Append(diags)
+			return resp
+		}
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 	resp.Deferred = convert.ProtoToDeferred(protoResp.Deferred)
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
 
-	state, err := decodeDynamicValue(protoResp.NewState, resSchema.Body.ImpliedType())
+	state, err := decodeDynamicValueWithLabel(protoResp.NewState, resSchema.Body.ImpliedType(), fmt.Sprintf("decoding new state for %s", r.TypeName))
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
+	resp.Diagnostics = resp.Diagnostics.Append(p.checkConformance(fmt.Sprintf("new state for %s", r.TypeName), state, resSchema.Body.ImpliedType()))
 	resp.NewState = state
 	resp.Private = protoResp.Private
 
 	if protoResp.NewIdentity != nil && protoResp.NewIdentity.IdentityData != nil {
 
 		if resSchema.Identity == nil {
-			resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown identity type %q", r.TypeName))
+			resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("identity type", r.TypeName))
+			return resp
 		}
 
 		resp.Identity, err = decodeDynamicValue(protoResp.NewIdentity.IdentityData, resSchema.Identity.ImpliedType())
 		if err != nil {
 			resp.Diagnostics = resp.Diagnostics.Append(err)
 		}
+		resp.Diagnostics = resp.Diagnostics.Append(p.checkConformance(fmt.Sprintf("identity for %s", r.TypeName), resp.Identity, resSchema.Identity.ImpliedType()))
 	}
 
 	return resp
 }
 
-func (p *GRPCProvider) PlanResourceChange(r providers.PlanResourceChangeRequest) (resp providers.PlanResourceChangeResponse) {
-	logger.Trace("GRPCProvider: PlanResourceChange")
+// ReadResourceByIdentity locates and reads a resource using only its
+// identity, sending a null prior state in place of one Terraform doesn't
+// have. It's gated on the resource type having an identity schema at all;
+// for resource types without one, it returns ErrUnimplemented, since there's
+// no way for the provider to locate a resource from identity alone.
+func (p *GRPCProvider) ReadResourceByIdentity(r providers.ReadResourceByIdentityRequest) (resp providers.ReadResourceByIdentityResponse) {
+	logger.Trace("GRPCProvider: ReadResourceByIdentity")
+	observe := p.observeCall("ReadResourceByIdentity")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("ReadResourceByIdentity", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
 	resSchema, ok := schema.ResourceTypes[r.TypeName]
 	if !ok {
-		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown resource type %q", r.TypeName))
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("resource type", r.TypeName))
+		return resp
+	}
+
+	if resSchema.Identity == nil {
+		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("%w: resource type %q has no identity schema, so it cannot be read by identity", ErrUnimplemented, r.TypeName))
+		return resp
+	}
+
+	identityMP, err := msgpack.Marshal(r.Identity, resSchema.Identity.ImpliedType())
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+
+	nullStateMP, err := msgpack.Marshal(cty.NullVal(resSchema.Body.ImpliedType()), resSchema.Body.ImpliedType())
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+
+	metaSchema := schema.ProviderMeta
+
+	protoReq := &proto.ReadResource_Request{
+		TypeName:     r.TypeName,
+		CurrentState: &proto.DynamicValue{Msgpack: nullStateMP},
+		Private:      r.Private,
+		CurrentIdentity: &proto.ResourceIdentityData{
+			IdentityData: &proto.DynamicValue{Msgpack: identityMP},
+		},
+		ClientCapabilities: clientCapabilitiesToProto(r.ClientCapabilities),
+	}
+
+	metaMP, err := encodeProviderMeta(metaSchema, r.ProviderMeta)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+	if metaMP != nil {
+		protoReq.ProviderMeta = &proto.DynamicValue{Msgpack: metaMP}
+	}
+
+	ctx := p.rpcContext()
+	protoResp, err := p.client.ReadResource(ctx, protoReq)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
+		return resp
+	}
+	resp.Deferred = convert.ProtoToDeferred(protoResp.Deferred)
+	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
+
+	state, err := decodeDynamicValueWithLabel(protoResp.NewState, resSchema.Body.ImpliedType(), fmt.Sprintf("decoding new state for %s", r.TypeName))
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+	resp.Diagnostics = resp.Diagnostics.Append(p.checkConformance(fmt.Sprintf("new state for %s", r.TypeName), state, resSchema.Body.ImpliedType()))
+	resp.NewState = state
+	resp.Private = protoResp.Private
+
+	resp.Identity = r.Identity
+	if protoResp.NewIdentity != nil && protoResp.NewIdentity.IdentityData != nil {
+		resp.Identity, err = decodeDynamicValue(protoResp.NewIdentity.IdentityData, resSchema.Identity.ImpliedType())
+		if err != nil {
+			resp.Diagnostics = resp.Diagnostics.Append(err)
+		}
+		resp.Diagnostics = resp.Diagnostics.Append(p.checkConformance(fmt.Sprintf("identity for %s", r.TypeName), resp.Identity, resSchema.Identity.ImpliedType()))
+	}
+
+	return resp
+}
+
+func (p *GRPCProvider) PlanResourceChange(r providers.PlanResourceChangeRequest) (resp providers.PlanResourceChangeResponse) {
+	logger.Trace("GRPCProvider: PlanResourceChange")
+	observe := p.observeCall("PlanResourceChange")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("PlanResourceChange", &resp.Diagnostics)
+
+	schema := p.GetProviderSchema()
+	if schema.Diagnostics.HasErrors() {
+		resp.Diagnostics = schema.Diagnostics
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
+	}
+
+	typeName := p.resolveResourceTypeName(r.TypeName)
+	resSchema, ok := schema.ResourceTypes[typeName]
+	if !ok {
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("resource type", typeName))
 		return resp
 	}
 
@@ -658,44 +1710,60 @@ func (p *GRPCProvider) PlanResourceChange(r providers.PlanResourceChangeRequest)
 		return resp
 	}
 
-	priorMP, err := msgpack.Marshal(r.PriorState, resSchema.Body.ImpliedType())
+	// When opted in, skip the round trip entirely for a genuine no-op: the
+	// proposed state is identical to what's already in state, the config has
+	// nothing unknown left to resolve, and the schema has no computed
+	// attributes a provider could still decide to change out from under us.
+	// Some providers do real work on every plan regardless (side effects,
+	// warnings), which is exactly why this is opt-in rather than automatic.
+	if p.SkipNoopPlans &&
+		r.ProposedNewState.RawEquals(r.PriorState) &&
+		r.Config.IsWhollyKnown() &&
+		!resSchema.Body.ContainsComputed() &&
+		(resSchema.Identity == nil || !resSchema.Identity.ContainsComputed()) {
+		resp.PlannedState = r.PriorState
+		resp.PlannedPrivate = r.PriorPrivate
+		resp.PlannedIdentity = r.PriorIdentity
+		return resp
+	}
+
+	priorStateDV, releasePriorState, err := p.encodeDynamicValuePooled(r.PriorState, resSchema.Body.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
+	defer releasePriorState()
 
-	configMP, err := msgpack.Marshal(r.Config, resSchema.Body.ImpliedType())
+	woAllowed := r.ClientCapabilities.WriteOnlyAttributesAllowed
+	configDV, releaseConfig, err := p.encodeDynamicValuePooled(stripWriteOnlyAttrs(resSchema, r.Config, woAllowed), resSchema.Body.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
+	defer releaseConfig()
 
-	propMP, err := msgpack.Marshal(r.ProposedNewState, resSchema.Body.ImpliedType())
+	proposedNewStateDV, releaseProposedNewState, err := p.encodeDynamicValuePooled(stripWriteOnlyAttrs(resSchema, r.ProposedNewState, woAllowed), resSchema.Body.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
+	defer releaseProposedNewState()
 
 	protoReq := &proto.PlanResourceChange_Request{
-		TypeName:           r.TypeName,
-		PriorState:         &proto.DynamicValue{Msgpack: priorMP},
-		Config:             &proto.DynamicValue{Msgpack: configMP},
-		ProposedNewState:   &proto.DynamicValue{Msgpack: propMP},
+		TypeName:           typeName,
+		PriorState:         priorStateDV,
+		Config:             configDV,
+		ProposedNewState:   proposedNewStateDV,
 		PriorPrivate:       r.PriorPrivate,
 		ClientCapabilities: clientCapabilitiesToProto(r.ClientCapabilities),
 	}
 
-	if metaSchema.Body != nil {
-		metaTy := metaSchema.Body.ImpliedType()
-		metaVal := r.ProviderMeta
-		if metaVal == cty.NilVal {
-			metaVal = cty.NullVal(metaTy)
-		}
-		metaMP, err := msgpack.Marshal(metaVal, metaTy)
-		if err != nil {
-			resp.Diagnostics = resp.Diagnostics.Append(err)
-			return resp
-		}
+	metaMP, err := encodeProviderMeta(metaSchema, r.ProviderMeta)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+	if metaMP != nil {
 		protoReq.ProviderMeta = &proto.DynamicValue{Msgpack: metaMP}
 	}
 
@@ -714,19 +1782,45 @@ func (p *GRPCProvider) PlanResourceChange(r providers.PlanResourceChangeRequest)
 		}
 	}
 
-	protoResp, err := p.client.PlanResourceChange(p.ctx, protoReq)
+	protoReq = p.beforeCall("PlanResourceChange", protoReq).(*proto.PlanResourceChange_Request)
+
+	operation := planOperation(r.PriorState, r.ProposedNewState)
+	ctx, limit, enforced, cancel := p.timeoutContext(resSchema, r.Config, operation)
+	defer cancel()
+
+	release, err := p.acquireConcurrencySlot(ctx, r.TypeName)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(concurrencyLimitDiags("PlanResourceChange", r.TypeName, err))
+		return resp
+	}
+	defer release()
+
+	protoResp, err := p.client.PlanResourceChange(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		if diags := timeoutDiagnostics(operation, limit, enforced, err); diags != nil {
+			resp.Diagnostics = resp.Diagnostics.Append(diags)
+			return resp
+		}
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
+	p.afterCall("PlanResourceChange", protoResp)
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
 
-	state, err := decodeDynamicValue(protoResp.PlannedState, resSchema.Body.ImpliedType())
+	state, err := decodeDynamicValueWithLabel(protoResp.PlannedState, resSchema.Body.ImpliedType(), fmt.Sprintf("decoding planned state for %s", r.TypeName))
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
+	if len(protoResp.SensitivePaths) > 0 {
+		sensitivePaths := make([]cty.Path, 0, len(protoResp.SensitivePaths))
+		for _, ap := range protoResp.SensitivePaths {
+			sensitivePaths = append(sensitivePaths, convert.AttributePathToPath(ap))
+		}
+		state = markSensitivePaths(state, sensitivePaths)
+	}
 	resp.PlannedState = state
+	resp.Diagnostics = resp.Diagnostics.Append(p.checkConformance(fmt.Sprintf("planned state for %s", r.TypeName), state, resSchema.Body.ImpliedType()))
 
 	for _, p := range protoResp.RequiresReplace {
 		resp.RequiresReplace = append(resp.RequiresReplace, convert.AttributePathToPath(p))
@@ -735,12 +1829,13 @@ func (p *GRPCProvider) PlanResourceChange(r providers.PlanResourceChangeRequest)
 	resp.PlannedPrivate = protoResp.PlannedPrivate
 
 	resp.LegacyTypeSystem = protoResp.LegacyTypeSystem
+	p.recordLegacyTypeSystem(resp.LegacyTypeSystem)
 
 	resp.Deferred = convert.ProtoToDeferred(protoResp.Deferred)
 
 	if protoResp.PlannedIdentity != nil && protoResp.PlannedIdentity.IdentityData != nil {
 		if resSchema.Identity == nil {
-			resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown identity type %s", r.TypeName))
+			resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("identity type", r.TypeName))
 			return resp
 		}
 
@@ -749,6 +1844,7 @@ func (p *GRPCProvider) PlanResourceChange(r providers.PlanResourceChangeRequest)
 			resp.Diagnostics = resp.Diagnostics.Append(err)
 			return resp
 		}
+		resp.Diagnostics = resp.Diagnostics.Append(p.checkConformance(fmt.Sprintf("planned identity for %s", r.TypeName), resp.PlannedIdentity, resSchema.Identity.ImpliedType()))
 	}
 
 	return resp
@@ -756,56 +1852,64 @@ func (p *GRPCProvider) PlanResourceChange(r providers.PlanResourceChangeRequest)
 
 func (p *GRPCProvider) ApplyResourceChange(r providers.ApplyResourceChangeRequest) (resp providers.ApplyResourceChangeResponse) {
 	logger.Trace("GRPCProvider: ApplyResourceChange")
+	observe := p.observeCall("ApplyResourceChange")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("ApplyResourceChange", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
-	resSchema, ok := schema.ResourceTypes[r.TypeName]
+	typeName := p.resolveResourceTypeName(r.TypeName)
+	resSchema, ok := schema.ResourceTypes[typeName]
 	if !ok {
-		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown resource type %q", r.TypeName))
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("resource type", typeName))
 		return resp
 	}
 
 	metaSchema := schema.ProviderMeta
 
-	priorMP, err := msgpack.Marshal(r.PriorState, resSchema.Body.ImpliedType())
+	priorStateDV, releasePriorState, err := p.encodeDynamicValuePooled(r.PriorState, resSchema.Body.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
-	plannedMP, err := msgpack.Marshal(r.PlannedState, resSchema.Body.ImpliedType())
+	defer releasePriorState()
+
+	woAllowed := r.ClientCapabilities.WriteOnlyAttributesAllowed
+	plannedStateDV, releasePlannedState, err := p.encodeDynamicValuePooled(stripWriteOnlyAttrs(resSchema, r.PlannedState, woAllowed), resSchema.Body.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
-	configMP, err := msgpack.Marshal(r.Config, resSchema.Body.ImpliedType())
+	defer releasePlannedState()
+
+	configDV, releaseConfig, err := p.encodeDynamicValuePooled(stripWriteOnlyAttrs(resSchema, r.Config, woAllowed), resSchema.Body.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
+	defer releaseConfig()
 
 	protoReq := &proto.ApplyResourceChange_Request{
-		TypeName:       r.TypeName,
-		PriorState:     &proto.DynamicValue{Msgpack: priorMP},
-		PlannedState:   &proto.DynamicValue{Msgpack: plannedMP},
-		Config:         &proto.DynamicValue{Msgpack: configMP},
+		TypeName:       typeName,
+		PriorState:     priorStateDV,
+		PlannedState:   plannedStateDV,
+		Config:         configDV,
 		PlannedPrivate: r.PlannedPrivate,
+		IdempotencyKey: r.IdempotencyKey,
 	}
 
-	if metaSchema.Body != nil {
-		metaTy := metaSchema.Body.ImpliedType()
-		metaVal := r.ProviderMeta
-		if metaVal == cty.NilVal {
-			metaVal = cty.NullVal(metaTy)
-		}
-		metaMP, err := msgpack.Marshal(metaVal, metaTy)
-		if err != nil {
-			resp.Diagnostics = resp.Diagnostics.Append(err)
-			return resp
-		}
+	metaMP, err := encodeProviderMeta(metaSchema, r.ProviderMeta)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+	if metaMP != nil {
 		protoReq.ProviderMeta = &proto.DynamicValue{Msgpack: metaMP}
 	}
 
@@ -824,23 +1928,55 @@ func (p *GRPCProvider) ApplyResourceChange(r providers.ApplyResourceChangeReques
 		}
 	}
 
-	protoResp, err := p.client.ApplyResourceChange(p.ctx, protoReq)
+	operation := applyOperation(r.PriorState, r.PlannedState)
+	ctx, limit, enforced, cancel := p.timeoutContext(resSchema, r.Config, operation)
+	defer cancel()
+
+	release, err := p.acquireConcurrencySlot(ctx, r.TypeName)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(concurrencyLimitDiags("ApplyResourceChange", r.TypeName, err))
+		return resp
+	}
+	defer release()
+
+	protoResp, err := p.client.ApplyResourceChange(ctx, protoReq, p.sendMsgSizeCallOptions()...)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		if diags := timeoutDiagnostics(operation, limit, enforced, err); diags != nil {
+			resp.Diagnostics = resp.Diagnostics.Append(diags)
+			return resp
+		}
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
 
 	resp.Private = protoResp.Private
 
-	state, err := decodeDynamicValue(protoResp.NewState, resSchema.Body.ImpliedType())
+	state, err := decodeDynamicValueWithLabel(protoResp.NewState, resSchema.Body.ImpliedType(), fmt.Sprintf("decoding new state for %s", r.TypeName))
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
 	resp.NewState = state
+	resp.Diagnostics = resp.Diagnostics.Append(p.checkConformance(fmt.Sprintf("new state for %s", r.TypeName), state, resSchema.Body.ImpliedType()))
 
 	resp.LegacyTypeSystem = protoResp.LegacyTypeSystem
+	p.recordLegacyTypeSystem(resp.LegacyTypeSystem)
+
+	// Applied values can't be unknown, since the resource has already been
+	// created or updated. The legacy SDK can produce unknown values here in
+	// some corner cases, so we only enforce this for providers that aren't
+	// relying on those allowances.
+	if !resp.LegacyTypeSystem {
+		if err := firstUnknownValueError(resp.NewState); err != nil {
+			resp.Diagnostics = resp.Diagnostics.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Provider produced an invalid new value",
+				fmt.Sprintf("Provider %q produced an invalid new value for %s, on resource type %q: the applied value is unknown. This is a bug in the provider, which should be reported in the provider's own issue tracker.",
+					p.Addr, tfdiags.FormatError(err), r.TypeName),
+			))
+		}
+	}
 
 	if protoResp.NewIdentity != nil && protoResp.NewIdentity.IdentityData != nil {
 		if resSchema.Identity == nil {
@@ -853,30 +1989,61 @@ func (p *GRPCProvider) ApplyResourceChange(r providers.ApplyResourceChangeReques
 			return resp
 		}
 		resp.NewIdentity = newIdentity
+		resp.Diagnostics = resp.Diagnostics.Append(p.checkConformance(fmt.Sprintf("new identity for %s", r.TypeName), newIdentity, resSchema.Identity.ImpliedType()))
 	}
 
+	resp.Notices = protoResp.Notices
+
 	return resp
 }
 
+// ApplyResourceChangeStream is meant to give the caller a live progress
+// event for each update the provider reports during a slow apply, the way
+// InvokeAction streams events for an action invocation. However, unlike
+// InvokeAction, ApplyResourceChange has no server-streaming variant in the
+// plugin protocol (ApplyResourceChange.Response is a single message, not a
+// stream), so no provider can actually advertise or deliver progress
+// events yet. Until the protocol gains one, this always falls back to the
+// unary ApplyResourceChange call and reports its result as a single
+// Completed event.
+func (p *GRPCProvider) ApplyResourceChangeStream(r providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeStreamResponse {
+	resp := p.ApplyResourceChange(r)
+
+	return providers.ApplyResourceChangeStreamResponse{
+		Events: providers.ApplyResourceChangeEvents{
+			Seq: func(yield func(providers.ApplyResourceChangeEvent) bool) {
+				yield(providers.ApplyResourceChangeEvent_Completed{Response: resp})
+			},
+			Cancel: func() {},
+		},
+	}
+}
+
 func (p *GRPCProvider) ImportResourceState(r providers.ImportResourceStateRequest) (resp providers.ImportResourceStateResponse) {
 	logger.Trace("GRPCProvider: ImportResourceState")
+	observe := p.observeCall("ImportResourceState")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("ImportResourceState", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
+	typeName := p.resolveResourceTypeName(r.TypeName)
 	protoReq := &proto.ImportResourceState_Request{
-		TypeName:           r.TypeName,
+		TypeName:           typeName,
 		Id:                 r.ID,
 		ClientCapabilities: clientCapabilitiesToProto(r.ClientCapabilities),
 	}
 
 	if !r.Identity.IsNull() {
-		resSchema := schema.ResourceTypes[r.TypeName]
+		resSchema := schema.ResourceTypes[typeName]
 		if resSchema.Identity == nil {
-			resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown identity type %q", r.TypeName))
+			resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("identity type", typeName))
 			return resp
 		}
 
@@ -893,9 +2060,10 @@ func (p *GRPCProvider) ImportResourceState(r providers.ImportResourceStateReques
 		}
 	}
 
-	protoResp, err := p.client.ImportResourceState(p.ctx, protoReq)
+	ctx := p.rpcContext()
+	protoResp, err := p.client.ImportResourceState(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
@@ -907,23 +2075,39 @@ func (p *GRPCProvider) ImportResourceState(r providers.ImportResourceStateReques
 			Private:  imported.Private,
 		}
 
-		resSchema, ok := schema.ResourceTypes[r.TypeName]
+		resSchema, ok := schema.ResourceTypes[typeName]
 		if !ok {
-			resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown resource type %q", r.TypeName))
+			resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("resource type", typeName))
 			continue
 		}
 
-		state, err := decodeDynamicValue(imported.State, resSchema.Body.ImpliedType())
+		state, err := decodeDynamicValueWithLabel(imported.State, resSchema.Body.ImpliedType(), fmt.Sprintf("decoding imported state for %s", imported.TypeName))
 		if err != nil {
 			resp.Diagnostics = resp.Diagnostics.Append(err)
 			return resp
 		}
 		resource.State = state
+		resp.Diagnostics = resp.Diagnostics.Append(p.checkConformance(fmt.Sprintf("state for %s", imported.TypeName), state, resSchema.Body.ImpliedType()))
+
+		for _, path := range writeOnlyValuePaths(resSchema.Body, state) {
+			resp.Diagnostics = resp.Diagnostics.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				fmt.Sprintf("Invalid import state for %q", imported.TypeName),
+				fmt.Sprintf(
+					"The provider returned a non-null value for %s, which is declared write-only and therefore cannot be persisted to state. This is a bug in the provider - please report it.",
+					tfdiags.FormatCtyPath(path),
+				),
+			))
+		}
 
 		if imported.Identity != nil && imported.Identity.IdentityData != nil {
 			importedIdentitySchema, ok := schema.ResourceTypes[imported.TypeName]
 			if !ok {
-				resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown resource type %q", imported.TypeName))
+				resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("resource type", imported.TypeName))
+				continue
+			}
+			if importedIdentitySchema.Identity == nil {
+				resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("identity type", imported.TypeName))
 				continue
 			}
 			importedIdentity, err := decodeDynamicValue(imported.Identity.IdentityData, importedIdentitySchema.Identity.ImpliedType())
@@ -932,6 +2116,7 @@ func (p *GRPCProvider) ImportResourceState(r providers.ImportResourceStateReques
 				return resp
 			}
 			resource.Identity = importedIdentity
+			resp.Diagnostics = resp.Diagnostics.Append(p.checkConformance(fmt.Sprintf("identity for %s", imported.TypeName), importedIdentity, importedIdentitySchema.Identity.ImpliedType()))
 		}
 
 		resp.ImportedResources = append(resp.ImportedResources, resource)
@@ -940,8 +2125,30 @@ func (p *GRPCProvider) ImportResourceState(r providers.ImportResourceStateReques
 	return resp
 }
 
+// ImportResourceStates imports a batch of resources in one call, preserving
+// the order of reqs and returning one response per request with its own
+// diagnostics.
+//
+// The tfplugin5 protocol has no batch-capable ImportResourceState RPC, so
+// there's currently no capability a provider can advertise to opt into a
+// single batched round trip; every call takes the per-item fallback path,
+// issuing one ImportResourceState RPC per req. This method exists as the
+// stable entry point callers can adopt now, so that a future protocol
+// version adding a real batch RPC only needs a change here, not at every
+// call site.
+func (p *GRPCProvider) ImportResourceStates(reqs []providers.ImportResourceStateRequest) []providers.ImportResourceStateResponse {
+	resps := make([]providers.ImportResourceStateResponse, len(reqs))
+	for i, r := range reqs {
+		resps[i] = p.ImportResourceState(r)
+	}
+	return resps
+}
+
 func (p *GRPCProvider) MoveResourceState(r providers.MoveResourceStateRequest) (resp providers.MoveResourceStateResponse) {
 	logger.Trace("GRPCProvider: MoveResourceState")
+	observe := p.observeCall("MoveResourceState")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("MoveResourceState", &resp.Diagnostics)
 
 	protoReq := &proto.MoveResourceState_Request{
 		SourceProviderAddress: r.SourceProviderAddress,
@@ -957,7 +2164,9 @@ func (p *GRPCProvider) MoveResourceState(r providers.MoveResourceStateRequest) (
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
 	if len(r.SourceIdentity) > 0 {
@@ -966,9 +2175,10 @@ func (p *GRPCProvider) MoveResourceState(r providers.MoveResourceStateRequest) (
 		}
 	}
 
-	protoResp, err := p.client.MoveResourceState(p.ctx, protoReq)
+	ctx := p.rpcContext()
+	protoResp, err := p.client.MoveResourceState(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
@@ -981,7 +2191,7 @@ func (p *GRPCProvider) MoveResourceState(r providers.MoveResourceStateRequest) (
 		// We should have validated this earlier in the process, but we'll
 		// still return an error instead of crashing in case something went
 		// wrong.
-		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown resource type %q; this is a bug in Terraform - please report it", r.TargetTypeName))
+		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("%w; this is a bug in Terraform - please report it", p.unknownTypeErr("resource type", r.TargetTypeName)))
 		return resp
 	}
 	resp.TargetState, err = decodeDynamicValue(protoResp.TargetState, targetType.Body.ImpliedType())
@@ -990,13 +2200,29 @@ func (p *GRPCProvider) MoveResourceState(r providers.MoveResourceStateRequest) (
 		return resp
 	}
 
+	if r.Validate {
+		// There's no wire protocol field to tell the provider that this
+		// move is a dry run, so it still performs the transform and we
+		// still decode its result above; what Validate changes is that we
+		// check the result's conformance unconditionally here, rather than
+		// only when StrictConformance is set, and the caller is expected
+		// to discard resp.TargetState instead of persisting it.
+		for _, err := range resp.TargetState.Type().TestConformance(targetType.Body.ImpliedType()) {
+			resp.Diagnostics = resp.Diagnostics.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				fmt.Sprintf("Invalid target state for %s", r.TargetTypeName),
+				format.ErrorDiag(err),
+			))
+		}
+	}
+
 	resp.TargetPrivate = protoResp.TargetPrivate
 
 	if protoResp.TargetIdentity != nil && protoResp.TargetIdentity.IdentityData != nil {
 		targetResSchema := schema.ResourceTypes[r.TargetTypeName]
 
 		if targetResSchema.Identity == nil {
-			resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown identity type %s", r.TargetTypeName))
+			resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("identity type", r.TargetTypeName))
 			return resp
 		}
 		resp.TargetIdentity, err = decodeDynamicValue(protoResp.TargetIdentity.IdentityData, targetResSchema.Identity.ImpliedType())
@@ -1011,77 +2237,130 @@ func (p *GRPCProvider) MoveResourceState(r providers.MoveResourceStateRequest) (
 
 func (p *GRPCProvider) ReadDataSource(r providers.ReadDataSourceRequest) (resp providers.ReadDataSourceResponse) {
 	logger.Trace("GRPCProvider: ReadDataSource")
+	observe := p.observeCall("ReadDataSource")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("ReadDataSource", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
 	dataSchema, ok := schema.DataSources[r.TypeName]
 	if !ok {
-		schema.Diagnostics = schema.Diagnostics.Append(fmt.Errorf("unknown data source %q", r.TypeName))
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("data source", r.TypeName))
+		return resp
 	}
 
 	metaSchema := schema.ProviderMeta
 
-	config, err := msgpack.Marshal(r.Config, dataSchema.Body.ImpliedType())
+	configDV, err := p.encodeDynamicValue(r.Config, dataSchema.Body.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
 
 	protoReq := &proto.ReadDataSource_Request{
-		TypeName: r.TypeName,
-		Config: &proto.DynamicValue{
-			Msgpack: config,
-		},
+		TypeName:           r.TypeName,
+		Config:             configDV,
 		ClientCapabilities: clientCapabilitiesToProto(r.ClientCapabilities),
 	}
 
-	if metaSchema.Body != nil {
-		metaMP, err := msgpack.Marshal(r.ProviderMeta, metaSchema.Body.ImpliedType())
+	metaMP, err := encodeProviderMeta(metaSchema, r.ProviderMeta)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+	if metaMP != nil {
+		protoReq.ProviderMeta = &proto.DynamicValue{Msgpack: metaMP}
+	}
+
+	configBytes := configDV.Msgpack
+	if configBytes == nil {
+		configBytes = configDV.Json
+	}
+
+	var cacheKey [sha256.Size]byte
+	if p.DataSourceCache != nil {
+		cacheKey = readDataSourceCacheKey(r.TypeName, configBytes, metaMP)
+		if cached, ok := p.DataSourceCache.get(cacheKey); ok {
+			logger.Trace("GRPCProvider: ReadDataSource: returning cached response", r.TypeName)
+			return cached
+		}
+	}
+
+	maxAttempts := 1
+	if r.RetryUntil != nil {
+		maxAttempts = readDataSourceRetryMaxAttempts
+	}
+
+	var state cty.Value
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		ctx := p.rpcContext()
+		protoResp, err := p.client.ReadDataSource(ctx, protoReq)
+		if err != nil {
+			resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
+			return resp
+		}
+		resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
+		if resp.Diagnostics.HasErrors() {
+			return resp
+		}
+
+		decoded, err := decodeDynamicValueWithLabel(protoResp.State, dataSchema.Body.ImpliedType(), fmt.Sprintf("decoding state for data source %s", r.TypeName))
 		if err != nil {
 			resp.Diagnostics = resp.Diagnostics.Append(err)
 			return resp
 		}
-		protoReq.ProviderMeta = &proto.DynamicValue{Msgpack: metaMP}
-	}
+		state = decoded
+		resp.Deferred = convert.ProtoToDeferred(protoResp.Deferred)
 
-	protoResp, err := p.client.ReadDataSource(p.ctx, protoReq)
-	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
-		return resp
+		if r.RetryUntil == nil || r.RetryUntil(state) {
+			break
+		}
+		if attempt == maxAttempts {
+			resp.Diagnostics = resp.Diagnostics.Append(tfdiags.Sourceless(
+				tfdiags.Warning,
+				fmt.Sprintf("Data source %s did not stabilize", r.TypeName),
+				fmt.Sprintf("Read data source %q %d time(s) waiting for an acceptable result, but the result never satisfied RetryUntil. Returning the last result read.", r.TypeName, maxAttempts),
+			))
+			break
+		}
+		time.Sleep(readDataSourceRetryInterval)
 	}
-	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
+	resp.State = state
 
-	state, err := decodeDynamicValue(protoResp.State, dataSchema.Body.ImpliedType())
-	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(err)
-		return resp
+	if p.DataSourceCache != nil && !resp.Diagnostics.HasErrors() {
+		p.DataSourceCache.set(cacheKey, resp)
 	}
-	resp.State = state
-	resp.Deferred = convert.ProtoToDeferred(protoResp.Deferred)
 
 	return resp
 }
 
 func (p *GRPCProvider) ValidateEphemeralResourceConfig(r providers.ValidateEphemeralResourceConfigRequest) (resp providers.ValidateEphemeralResourceConfigResponse) {
 	logger.Trace("GRPCProvider: ValidateEphemeralResourceConfig")
+	observe := p.observeCall("ValidateEphemeralResourceConfig")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("ValidateEphemeralResourceConfig", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
 	ephemSchema, ok := schema.EphemeralResourceTypes[r.TypeName]
 	if !ok {
-		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown ephemeral resource %q", r.TypeName))
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("ephemeral resource", r.TypeName))
 		return resp
 	}
 
-	mp, err := msgpack.Marshal(r.Config, ephemSchema.Body.ImpliedType())
+	configDV, err := p.encodeDynamicValue(r.Config, ephemSchema.Body.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
@@ -1089,12 +2368,13 @@ func (p *GRPCProvider) ValidateEphemeralResourceConfig(r providers.ValidateEphem
 
 	protoReq := &proto.ValidateEphemeralResourceConfig_Request{
 		TypeName: r.TypeName,
-		Config:   &proto.DynamicValue{Msgpack: mp},
+		Config:   configDV,
 	}
 
-	protoResp, err := p.client.ValidateEphemeralResourceConfig(p.ctx, protoReq)
+	ctx := p.rpcContext()
+	protoResp, err := p.client.ValidateEphemeralResourceConfig(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
@@ -1103,36 +2383,40 @@ func (p *GRPCProvider) ValidateEphemeralResourceConfig(r providers.ValidateEphem
 
 func (p *GRPCProvider) OpenEphemeralResource(r providers.OpenEphemeralResourceRequest) (resp providers.OpenEphemeralResourceResponse) {
 	logger.Trace("GRPCProvider: OpenEphemeralResource")
+	observe := p.observeCall("OpenEphemeralResource")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("OpenEphemeralResource", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
 	ephemSchema, ok := schema.EphemeralResourceTypes[r.TypeName]
 	if !ok {
-		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown ephemeral resource %q", r.TypeName))
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("ephemeral resource", r.TypeName))
 		return resp
 	}
 
-	config, err := msgpack.Marshal(r.Config, ephemSchema.Body.ImpliedType())
+	configDV, err := p.encodeDynamicValue(r.Config, ephemSchema.Body.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
 
 	protoReq := &proto.OpenEphemeralResource_Request{
-		TypeName: r.TypeName,
-		Config: &proto.DynamicValue{
-			Msgpack: config,
-		},
+		TypeName:           r.TypeName,
+		Config:             configDV,
 		ClientCapabilities: clientCapabilitiesToProto(r.ClientCapabilities),
 	}
 
-	protoResp, err := p.client.OpenEphemeralResource(p.ctx, protoReq)
+	ctx := p.rpcContext()
+	protoResp, err := p.client.OpenEphemeralResource(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
@@ -1145,6 +2429,7 @@ func (p *GRPCProvider) OpenEphemeralResource(r providers.OpenEphemeralResourceRe
 
 	if protoResp.RenewAt != nil {
 		resp.RenewAt = protoResp.RenewAt.AsTime()
+		resp.RenewAt, resp.Diagnostics = p.warnIfRenewAtElapsed(r.TypeName, resp.RenewAt, resp.Diagnostics)
 	}
 
 	resp.Result = state
@@ -1156,39 +2441,52 @@ func (p *GRPCProvider) OpenEphemeralResource(r providers.OpenEphemeralResourceRe
 
 func (p *GRPCProvider) RenewEphemeralResource(r providers.RenewEphemeralResourceRequest) (resp providers.RenewEphemeralResourceResponse) {
 	logger.Trace("GRPCProvider: RenewEphemeralResource")
+	observe := p.observeCall("RenewEphemeralResource")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("RenewEphemeralResource", &resp.Diagnostics)
 
 	protoReq := &proto.RenewEphemeralResource_Request{
 		TypeName: r.TypeName,
 		Private:  r.Private,
 	}
 
-	protoResp, err := p.client.RenewEphemeralResource(p.ctx, protoReq)
+	ctx := p.rpcContext()
+	protoResp, err := p.client.RenewEphemeralResource(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
 
 	if protoResp.RenewAt != nil {
 		resp.RenewAt = protoResp.RenewAt.AsTime()
+		resp.RenewAt, resp.Diagnostics = p.warnIfRenewAtElapsed(r.TypeName, resp.RenewAt, resp.Diagnostics)
 	}
 
 	resp.Private = protoResp.Private
 
+	// Unlike OpenEphemeralResource.Response, RenewEphemeralResource.Response
+	// has no deferred field on the wire, so resp.Deferred stays nil until
+	// the protocol grows one.
+
 	return resp
 }
 
 func (p *GRPCProvider) CloseEphemeralResource(r providers.CloseEphemeralResourceRequest) (resp providers.CloseEphemeralResourceResponse) {
 	logger.Trace("GRPCProvider: CloseEphemeralResource")
+	observe := p.observeCall("CloseEphemeralResource")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("CloseEphemeralResource", &resp.Diagnostics)
 
 	protoReq := &proto.CloseEphemeralResource_Request{
 		TypeName: r.TypeName,
 		Private:  r.Private,
 	}
 
-	protoResp, err := p.client.CloseEphemeralResource(p.ctx, protoReq)
+	ctx := p.rpcContext()
+	protoResp, err := p.client.CloseEphemeralResource(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
@@ -1196,13 +2494,31 @@ func (p *GRPCProvider) CloseEphemeralResource(r providers.CloseEphemeralResource
 	return resp
 }
 
+// CloseEphemeralResources closes every ephemeral resource in items,
+// aggregating their diagnostics into a single result. Every item is
+// attempted even if an earlier one fails, so that one bad close doesn't
+// leak the rest of the phase's ephemeral resources. The order in which
+// items are closed is unspecified.
+func (p *GRPCProvider) CloseEphemeralResources(items []providers.CloseEphemeralResourceRequest) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	for _, item := range items {
+		resp := p.CloseEphemeralResource(item)
+		diags = diags.Append(resp.Diagnostics)
+	}
+	return diags
+}
+
 func (p *GRPCProvider) CallFunction(r providers.CallFunctionRequest) (resp providers.CallFunctionResponse) {
 	logger.Trace("GRPCProvider", "CallFunction", r.FunctionName)
+	observe := p.observeCall("CallFunction")
+	defer func() { observe(resp.Err) }()
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Err = schema.Diagnostics.Err()
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
 	funcDecl, ok := schema.Functions[r.FunctionName]
@@ -1227,6 +2543,8 @@ func (p *GRPCProvider) CallFunction(r providers.CallFunctionRequest) (resp provi
 		return resp
 	}
 	args := make([]*proto.DynamicValue, len(r.Arguments))
+	hash := sha256.New()
+	hash.Write([]byte(r.FunctionName))
 	for i, argVal := range r.Arguments {
 		var paramDecl providers.FunctionParam
 		if i < len(funcDecl.Parameters) {
@@ -1235,6 +2553,18 @@ func (p *GRPCProvider) CallFunction(r providers.CallFunctionRequest) (resp provi
 			paramDecl = *funcDecl.VariadicParameter
 		}
 
+		// Validating these here, rather than leaving it for the provider to
+		// discover, gives a precise function.ArgError pointing at the
+		// offending argument instead of an opaque provider-side failure.
+		if argVal.IsNull() && !paramDecl.AllowNullValue {
+			resp.Err = function.NewArgError(i, fmt.Errorf("argument %q cannot be null", paramDecl.Name))
+			return resp
+		}
+		if !argVal.IsWhollyKnown() && !paramDecl.AllowUnknownValues {
+			resp.Err = function.NewArgError(i, fmt.Errorf("argument %q cannot be unknown", paramDecl.Name))
+			return resp
+		}
+
 		argValRaw, err := msgpack.Marshal(argVal, paramDecl.Type)
 		if err != nil {
 			resp.Err = err
@@ -1243,9 +2573,20 @@ func (p *GRPCProvider) CallFunction(r providers.CallFunctionRequest) (resp provi
 		args[i] = &proto.DynamicValue{
 			Msgpack: argValRaw,
 		}
+		hash.Write(argValRaw)
+	}
+
+	var cacheKey [sha256.Size]byte
+	if p.FunctionCache != nil {
+		copy(cacheKey[:], hash.Sum(nil))
+		if cached, ok := p.FunctionCache.get(cacheKey); ok {
+			logger.Trace("GRPCProvider: CallFunction: returning cached result", r.FunctionName)
+			return cached
+		}
 	}
 
-	protoResp, err := p.client.CallFunction(p.ctx, &proto.CallFunction_Request{
+	ctx := p.rpcContext()
+	protoResp, err := p.client.CallFunction(ctx, &proto.CallFunction_Request{
 		Name:      r.FunctionName,
 		Arguments: args,
 	})
@@ -1253,7 +2594,7 @@ func (p *GRPCProvider) CallFunction(r providers.CallFunctionRequest) (resp provi
 		// functions can only support simple errors, but use our grpcError
 		// diagnostic function to format common problems is a more
 		// user-friendly manner.
-		resp.Err = grpcErr(err).Err()
+		resp.Err = grpcErr(ctx, err).Err()
 		return resp
 	}
 
@@ -1276,22 +2617,62 @@ func (p *GRPCProvider) CallFunction(r providers.CallFunctionRequest) (resp provi
 	}
 
 	resp.Result = resultVal
+
+	if p.FunctionCache != nil {
+		p.FunctionCache.set(cacheKey, resp)
+	}
+
 	return resp
 }
 
+// CallFunctions calls CallFunction for each of reqs, dispatching up to
+// concurrency calls at once over the shared connection. Results are returned
+// in the same order as reqs regardless of completion order, so the caller
+// can zip them back up with whatever keyed the calls on its side. A
+// concurrency of 1 or less runs the calls sequentially.
+func (p *GRPCProvider) CallFunctions(reqs []providers.CallFunctionRequest, concurrency int) []providers.CallFunctionResponse {
+	resps := make([]providers.CallFunctionResponse, len(reqs))
+	if len(reqs) == 0 {
+		return resps
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, req := range reqs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, req providers.CallFunctionRequest) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			resps[i] = p.CallFunction(req)
+		}(i, req)
+	}
+	wg.Wait()
+
+	return resps
+}
+
 func (p *GRPCProvider) ListResource(r providers.ListResourceRequest) providers.ListResourceResponse {
 	logger.Trace("GRPCProvider: ListResource")
 	var resp providers.ListResourceResponse
+	observe := p.observeCall("ListResource")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("ListResource", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
 	listResourceSchema, ok := schema.ListResourceTypes[r.TypeName]
 	if !ok {
-		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown list resource type %q", r.TypeName))
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("list resource type", r.TypeName))
 		return resp
 	}
 
@@ -1306,7 +2687,7 @@ func (p *GRPCProvider) ListResource(r providers.ListResourceRequest) providers.L
 	if r.Config.Type().HasAttribute("config") {
 		config = r.Config.GetAttr("config")
 	}
-	mp, err := msgpack.Marshal(config, configSchema.ImpliedType())
+	configDV, err := p.encodeDynamicValue(config, configSchema.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
@@ -1314,7 +2695,7 @@ func (p *GRPCProvider) ListResource(r providers.ListResourceRequest) providers.L
 
 	protoReq := &proto.ListResource_Request{
 		TypeName:              r.TypeName,
-		Config:                &proto.DynamicValue{Msgpack: mp},
+		Config:                configDV,
 		IncludeResourceObject: r.IncludeResourceObject,
 		Limit:                 r.Limit,
 	}
@@ -1322,7 +2703,7 @@ func (p *GRPCProvider) ListResource(r providers.ListResourceRequest) providers.L
 	// Start the streaming RPC with a context. The context will be cancelled
 	// when this function returns, which will stop the stream if it is still
 	// running.
-	ctx, cancel := context.WithCancel(p.ctx)
+	ctx, cancel := context.WithCancel(p.rpcContext())
 	defer cancel()
 	client, err := p.client.ListResource(ctx, protoReq)
 	if err != nil {
@@ -1340,7 +2721,7 @@ func (p *GRPCProvider) ListResource(r providers.ListResourceRequest) providers.L
 		}
 
 		event, err := client.Recv()
-		if err == io.EOF {
+		if errors.Is(err, io.EOF) {
 			// End of stream, we're done
 			break
 		}
@@ -1357,9 +2738,18 @@ func (p *GRPCProvider) ListResource(r providers.ListResourceRequest) providers.L
 		}
 		resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(event.Diagnostic))
 
-		// Handle identity data - it must be present
+		// Handle identity data - it must be present unless the caller opted
+		// into tolerating its absence.
 		if event.Identity == nil || event.Identity.IdentityData == nil {
-			resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("missing identity data in ListResource event for %s", r.TypeName))
+			if r.AllowMissingIdentity {
+				resp.Diagnostics = resp.Diagnostics.Append(tfdiags.Sourceless(
+					tfdiags.Warning,
+					"Missing identity data in ListResource event",
+					fmt.Sprintf("The provider omitted identity data for a %s result; it will be included with a null identity.", r.TypeName),
+				))
+			} else {
+				resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("missing identity data in ListResource event for %s", r.TypeName))
+			}
 		} else {
 			identityVal, err := decodeDynamicValue(event.Identity.IdentityData, resourceSchema.Identity.ImpliedType())
 			if err != nil {
@@ -1378,6 +2768,15 @@ func (p *GRPCProvider) ListResource(r providers.ListResourceRequest) providers.L
 			} else {
 				obj["state"] = resourceObj
 			}
+		} else if event.ResourceObject == nil && !r.IncludeResourceObject && r.Hydrate != nil && r.Hydrate(obj["identity"]) {
+			hydrated := p.ReadResourceByIdentity(providers.ReadResourceByIdentityRequest{
+				TypeName: r.TypeName,
+				Identity: obj["identity"],
+			})
+			resp.Diagnostics = resp.Diagnostics.Append(hydrated.Diagnostics)
+			if !hydrated.Diagnostics.HasErrors() {
+				obj["state"] = hydrated.NewState
+			}
 		}
 
 		if resp.Diagnostics.HasErrors() {
@@ -1399,74 +2798,293 @@ func (p *GRPCProvider) ListResource(r providers.ListResourceRequest) providers.L
 	return resp
 }
 
-func (p *GRPCProvider) ValidateStateStoreConfig(r providers.ValidateStateStoreConfigRequest) providers.ValidateStateStoreConfigResponse {
-	panic("not implemented")
-}
-
-func (p *GRPCProvider) ConfigureStateStore(r providers.ConfigureStateStoreRequest) providers.ConfigureStateStoreResponse {
-	panic("not implemented")
-}
-
-func (p *GRPCProvider) GetStates(r providers.GetStatesRequest) providers.GetStatesResponse {
-	panic("not implemented")
-}
-
-func (p *GRPCProvider) DeleteState(r providers.DeleteStateRequest) providers.DeleteStateResponse {
-	panic("not implemented")
-}
-
-func (p *GRPCProvider) PlanAction(r providers.PlanActionRequest) (resp providers.PlanActionResponse) {
-	logger.Trace("GRPCProvider: PlanAction")
+// StreamListResource runs a ListResource stream the same way ListResource
+// does, but instead of collecting every decoded result object into an
+// in-memory cty.TupleVal, it hands each one to sink as soon as it's decoded.
+// This keeps peak memory bounded by one object at a time rather than the
+// full result set, for callers iterating a list resource that may produce
+// far more results than are worth holding in memory at once. It returns the
+// number of objects delivered to sink and any diagnostics accumulated along
+// the way; a non-nil error from sink is appended as a diagnostic and stops
+// the stream early, the same as a decode error would.
+func (p *GRPCProvider) StreamListResource(r providers.ListResourceRequest, sink func(cty.Value) error) (count int64, diags tfdiags.Diagnostics) {
+	logger.Trace("GRPCProvider: StreamListResource")
+	observe := p.observeCall("StreamListResource")
+	defer func() { observe(diags.Err()) }()
+	defer p.recoverPanic("StreamListResource", &diags)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
-		resp.Diagnostics = schema.Diagnostics
-		return resp
+		diags = schema.Diagnostics
+		if !p.ContinueOnSchemaError {
+			return 0, diags
+		}
 	}
 
-	actionSchema, ok := schema.Actions[r.ActionType]
+	listResourceSchema, ok := schema.ListResourceTypes[r.TypeName]
 	if !ok {
-		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown action %q", r.ActionType))
-		return resp
+		diags = diags.Append(p.unknownTypeErr("list resource type", r.TypeName))
+		return 0, diags
 	}
 
-	configMP, err := msgpack.Marshal(r.ProposedActionData, actionSchema.ConfigSchema.ImpliedType())
-	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(err)
-		return resp
+	resourceSchema, ok := schema.ResourceTypes[r.TypeName]
+	if !ok || resourceSchema.Identity == nil {
+		diags = diags.Append(fmt.Errorf("identity schema not found for resource type %s", r.TypeName))
+		return 0, diags
 	}
 
-	linkedResources, err := linkedResourcePlanDataToProto(schema, actionSchema.LinkedResources(), r.LinkedResources)
-	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(err)
-		return resp
+	configSchema := listResourceSchema.Body.BlockTypes["config"]
+	config := cty.NullVal(configSchema.ImpliedType())
+	if r.Config.Type().HasAttribute("config") {
+		config = r.Config.GetAttr("config")
 	}
-
-	protoReq := &proto.PlanAction_Request{
-		ActionType:         r.ActionType,
-		Config:             &proto.DynamicValue{Msgpack: configMP},
-		LinkedResources:    linkedResources,
-		ClientCapabilities: clientCapabilitiesToProto(r.ClientCapabilities),
+	configDV, err := p.encodeDynamicValue(config, configSchema.ImpliedType())
+	if err != nil {
+		diags = diags.Append(err)
+		return 0, diags
 	}
 
-	protoResp, err := p.client.PlanAction(p.ctx, protoReq)
+	protoReq := &proto.ListResource_Request{
+		TypeName:              r.TypeName,
+		Config:                configDV,
+		IncludeResourceObject: r.IncludeResourceObject,
+		Limit:                 r.Limit,
+	}
+
+	ctx, cancel := context.WithCancel(p.rpcContext())
+	defer cancel()
+	client, err := p.client.ListResource(ctx, protoReq)
+	if err != nil {
+		diags = diags.Append(err)
+		return 0, diags
+	}
+
+	for {
+		if count >= r.Limit {
+			break
+		}
+
+		event, err := client.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			diags = diags.Append(err)
+			break
+		}
+
+		obj := map[string]cty.Value{
+			"display_name": cty.StringVal(event.DisplayName),
+			"state":        cty.NullVal(resourceSchema.Body.ImpliedType()),
+			"identity":     cty.NullVal(resourceSchema.Identity.ImpliedType()),
+		}
+		diags = diags.Append(convert.ProtoToDiagnostics(event.Diagnostic))
+
+		if event.Identity == nil || event.Identity.IdentityData == nil {
+			if r.AllowMissingIdentity {
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Warning,
+					"Missing identity data in ListResource event",
+					fmt.Sprintf("The provider omitted identity data for a %s result; it will be included with a null identity.", r.TypeName),
+				))
+			} else {
+				diags = diags.Append(fmt.Errorf("missing identity data in ListResource event for %s", r.TypeName))
+			}
+		} else {
+			identityVal, err := decodeDynamicValue(event.Identity.IdentityData, resourceSchema.Identity.ImpliedType())
+			if err != nil {
+				diags = diags.Append(err)
+			} else {
+				obj["identity"] = identityVal
+			}
+		}
+
+		if event.ResourceObject != nil && r.IncludeResourceObject {
+			resourceObj, err := decodeDynamicValue(event.ResourceObject, resourceSchema.Body.ImpliedType())
+			if err != nil {
+				diags = diags.Append(err)
+			} else {
+				obj["state"] = resourceObj
+			}
+		} else if event.ResourceObject == nil && !r.IncludeResourceObject && r.Hydrate != nil && r.Hydrate(obj["identity"]) {
+			hydrated := p.ReadResourceByIdentity(providers.ReadResourceByIdentityRequest{
+				TypeName: r.TypeName,
+				Identity: obj["identity"],
+			})
+			diags = diags.Append(hydrated.Diagnostics)
+			if !hydrated.Diagnostics.HasErrors() {
+				obj["state"] = hydrated.NewState
+			}
+		}
+
+		if diags.HasErrors() {
+			break
+		}
+
+		if err := sink(cty.ObjectVal(obj)); err != nil {
+			diags = diags.Append(err)
+			break
+		}
+		count++
+	}
+
+	return count, diags
+}
+
+// CountListResource runs a ListResource stream the same way ListResource
+// does, but it neither requests the full resource object nor decodes the
+// identity of each event, so it can report how many results a list resource
+// would produce much more cheaply than building the whole cty tuple. It
+// still respects r.Limit, since a caller asking for a capped count should
+// get the capped count rather than the provider's true total.
+func (p *GRPCProvider) CountListResource(r providers.ListResourceRequest) (int64, tfdiags.Diagnostics) {
+	logger.Trace("GRPCProvider: CountListResource")
+	var diags tfdiags.Diagnostics
+	observe := p.observeCall("CountListResource")
+	defer func() { observe(diags.Err()) }()
+	defer p.recoverPanic("CountListResource", &diags)
+
+	schema := p.GetProviderSchema()
+	if schema.Diagnostics.HasErrors() {
+		diags = schema.Diagnostics
+		if !p.ContinueOnSchemaError {
+			return 0, diags
+		}
+	}
+
+	listResourceSchema, ok := schema.ListResourceTypes[r.TypeName]
+	if !ok {
+		diags = diags.Append(p.unknownTypeErr("list resource type", r.TypeName))
+		return 0, diags
+	}
+
+	configSchema := listResourceSchema.Body.BlockTypes["config"]
+	config := cty.NullVal(configSchema.ImpliedType())
+	if r.Config.Type().HasAttribute("config") {
+		config = r.Config.GetAttr("config")
+	}
+	configDV, err := p.encodeDynamicValue(config, configSchema.ImpliedType())
+	if err != nil {
+		diags = diags.Append(err)
+		return 0, diags
+	}
+
+	protoReq := &proto.ListResource_Request{
+		TypeName:              r.TypeName,
+		Config:                configDV,
+		IncludeResourceObject: false,
+		Limit:                 r.Limit,
+	}
+
+	ctx, cancel := context.WithCancel(p.rpcContext())
+	defer cancel()
+	client, err := p.client.ListResource(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		diags = diags.Append(err)
+		return 0, diags
+	}
+
+	var count int64
+	for {
+		if count >= r.Limit {
+			break
+		}
+
+		event, err := client.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			diags = diags.Append(err)
+			break
+		}
+
+		diags = diags.Append(convert.ProtoToDiagnostics(event.Diagnostic))
+		if diags.HasErrors() {
+			break
+		}
+
+		count++
+	}
+
+	return count, diags
+}
+
+func (p *GRPCProvider) ValidateStateStoreConfig(r providers.ValidateStateStoreConfigRequest) providers.ValidateStateStoreConfigResponse {
+	panic("not implemented")
+}
+
+func (p *GRPCProvider) ConfigureStateStore(r providers.ConfigureStateStoreRequest) providers.ConfigureStateStoreResponse {
+	panic("not implemented")
+}
+
+func (p *GRPCProvider) GetStates(r providers.GetStatesRequest) providers.GetStatesResponse {
+	panic("not implemented")
+}
+
+func (p *GRPCProvider) DeleteState(r providers.DeleteStateRequest) providers.DeleteStateResponse {
+	panic("not implemented")
+}
+
+func (p *GRPCProvider) PlanAction(r providers.PlanActionRequest) (resp providers.PlanActionResponse) {
+	logger.Trace("GRPCProvider: PlanAction")
+	observe := p.observeCall("PlanAction")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("PlanAction", &resp.Diagnostics)
+
+	schema := p.GetProviderSchema()
+	if schema.Diagnostics.HasErrors() {
+		resp.Diagnostics = schema.Diagnostics
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
+	}
+
+	actionSchema, ok := schema.Actions[r.ActionType]
+	if !ok {
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("action type", r.ActionType))
 		return resp
 	}
 
-	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
+	if err := validateActionConfigConformance(r.ActionType, actionSchema, r.ProposedActionData); err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+
+	configDV, err := p.encodeDynamicValue(r.ProposedActionData, actionSchema.ConfigSchema.ImpliedType())
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+
+	linkedResources, err := linkedResourcePlanDataToProto(schema, actionSchema.LinkedResources(), r.LinkedResources)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
+
+	protoReq := &proto.PlanAction_Request{
+		ActionType:         r.ActionType,
+		Config:             configDV,
+		LinkedResources:    linkedResources,
+		ClientCapabilities: clientCapabilitiesToProto(r.ClientCapabilities),
+	}
+
+	ctx := p.rpcContext()
+	protoResp, err := p.client.PlanAction(ctx, protoReq)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
+		return resp
+	}
+
+	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
 	if resp.Diagnostics.HasErrors() {
 		return resp
 	}
 
 	resp.LinkedResources, err = protoToLinkedResourcePlans(schema, actionSchema.LinkedResources(), protoResp.LinkedResources)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
 
@@ -1475,16 +3093,26 @@ func (p *GRPCProvider) PlanAction(r providers.PlanActionRequest) (resp providers
 
 func (p *GRPCProvider) InvokeAction(r providers.InvokeActionRequest) (resp providers.InvokeActionResponse) {
 	logger.Trace("GRPCProvider: InvokeAction")
+	observe := p.observeCall("InvokeAction")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("InvokeAction", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
 	actionSchema, ok := schema.Actions[r.ActionType]
 	if !ok {
-		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown action %q", r.ActionType))
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("action type", r.ActionType))
+		return resp
+	}
+
+	if err := validateActionConfigConformance(r.ActionType, actionSchema, r.PlannedActionData); err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
 	}
 
@@ -1494,7 +3122,7 @@ func (p *GRPCProvider) InvokeAction(r providers.InvokeActionRequest) (resp provi
 		return resp
 	}
 
-	configMP, err := msgpack.Marshal(r.PlannedActionData, actionSchema.ConfigSchema.ImpliedType())
+	configDV, err := p.encodeDynamicValue(r.PlannedActionData, actionSchema.ConfigSchema.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
@@ -1502,55 +3130,124 @@ func (p *GRPCProvider) InvokeAction(r providers.InvokeActionRequest) (resp provi
 
 	protoReq := &proto.InvokeAction_Request{
 		ActionType:      r.ActionType,
-		Config:          &proto.DynamicValue{Msgpack: configMP},
+		Config:          configDV,
 		LinkedResources: linkedResources,
 	}
 
-	protoClient, err := p.client.InvokeAction(p.ctx, protoReq)
+	ctx, cancel := context.WithCancel(p.rpcContext())
+	protoClient, err := p.client.InvokeAction(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		cancel()
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 
-	resp.Events = func(yield func(providers.InvokeActionEvent) bool) {
-		logger.Trace("GRPCProvider: InvokeAction: streaming events")
+	// recvEvent receives and decodes a single event from protoClient. event
+	// is only meaningful when hasEvent is true; more is false once the
+	// stream is exhausted, whether that's because it ended cleanly (no
+	// event) or because a receive error was turned into a final Completed
+	// event (hasEvent true, more false).
+	recvEvent := func() (event providers.InvokeActionEvent, hasEvent, more bool) {
+		raw, err := protoClient.Recv()
+		if errors.Is(err, io.EOF) {
+			logger.Trace("GRPCProvider: InvokeAction: end of stream")
+			return nil, false, false
+		}
+		if err != nil {
+			// We handle this by returning a finished response with the error
+			// If the client errors we won't be receiving any more events.
+			return providers.InvokeActionEvent_Completed{
+				Diagnostics: grpcErr(ctx, err),
+			}, true, false
+		}
 
-		for {
-			event, err := protoClient.Recv()
-			if err == io.EOF {
-				logger.Trace("GRPCProvider: InvokeAction: end of stream")
-				break
-			}
+		switch ev := raw.Type.(type) {
+		case *proto.InvokeAction_Event_Progress_:
+			// The wire protocol doesn't carry a completion fraction yet,
+			// so we always report it as unknown.
+			return providers.InvokeActionEvent_Progress{
+				Message:  ev.Progress.Message,
+				Fraction: -1,
+			}, true, true
+
+		case *proto.InvokeAction_Event_Completed_:
+			diags := convert.ProtoToDiagnostics(ev.Completed.Diagnostics)
+			linkedResources, err := protoToLinkedResourceResults(schema, actionSchema.LinkedResources(), ev.Completed.LinkedResources)
 			if err != nil {
-				// We handle this by returning a finished response with the error
-				// If the client errors we won't be receiving any more events.
-				yield(providers.InvokeActionEvent_Completed{
-					Diagnostics: grpcErr(err),
-				})
-				break
+				diags = diags.Append(err)
 			}
+			return providers.InvokeActionEvent_Completed{
+				LinkedResources: linkedResources,
+				Diagnostics:     diags,
+			}, true, true
+
+		default:
+			panic(fmt.Sprintf("unexpected event type %T in InvokeAction response", raw.Type))
+		}
+	}
 
-			switch ev := event.Type.(type) {
-			case *proto.InvokeAction_Event_Progress_:
-				yield(providers.InvokeActionEvent_Progress{
-					Message: ev.Progress.Message,
-				})
-
-			case *proto.InvokeAction_Event_Completed_:
-				diags := convert.ProtoToDiagnostics(ev.Completed.Diagnostics)
-				linkedResources, err := protoToLinkedResourceResults(schema, actionSchema.LinkedResources(), ev.Completed.LinkedResources)
-				if err != nil {
-					diags = diags.Append(grpcErr(err))
+	if r.BufferSize <= 0 {
+		resp.Events = providers.InvokeActionEvents{
+			Seq: func(yield func(providers.InvokeActionEvent) bool) {
+				logger.Trace("GRPCProvider: InvokeAction: streaming events")
+				defer cancel()
+
+				for {
+					event, hasEvent, more := recvEvent()
+					if hasEvent && !yield(event) {
+						return
+					}
+					if !more {
+						return
+					}
 				}
-				yield(providers.InvokeActionEvent_Completed{
-					LinkedResources: linkedResources,
-					Diagnostics:     diags,
-				})
+			},
+			Cancel: cancel,
+		}
+		return resp
+	}
 
-			default:
-				panic(fmt.Sprintf("unexpected event type %T in InvokeAction response", event.Type))
+	// With BufferSize set, a background goroutine pulls events from the
+	// stream into a buffered channel ahead of the consumer, so the provider
+	// can keep producing while the consumer is busy handling one, instead
+	// of the two being in lockstep. The goroutine exits, without leaking,
+	// either when the stream ends, when ctx is done, or when the consumer
+	// stops iterating early (signaled by closing done).
+	resp.Events = providers.InvokeActionEvents{
+		Seq: func(yield func(providers.InvokeActionEvent) bool) {
+			logger.Trace("GRPCProvider: InvokeAction: streaming events (buffered)")
+			defer cancel()
+
+			events := make(chan providers.InvokeActionEvent, r.BufferSize)
+			done := make(chan struct{})
+			defer close(done)
+
+			go func() {
+				defer close(events)
+				for {
+					event, hasEvent, more := recvEvent()
+					if hasEvent {
+						select {
+						case events <- event:
+						case <-done:
+							return
+						case <-ctx.Done():
+							return
+						}
+					}
+					if !more {
+						return
+					}
+				}
+			}()
+
+			for event := range events {
+				if !yield(event) {
+					return
+				}
 			}
-		}
+		},
+		Cancel: cancel,
 	}
 
 	return resp
@@ -1558,20 +3255,25 @@ func (p *GRPCProvider) InvokeAction(r providers.InvokeActionRequest) (resp provi
 
 func (p *GRPCProvider) ValidateActionConfig(r providers.ValidateActionConfigRequest) (resp providers.ValidateActionConfigResponse) {
 	logger.Trace("GRPCProvider: ValidateActionConfig")
+	observe := p.observeCall("ValidateActionConfig")
+	defer func() { observe(resp.Diagnostics.Err()) }()
+	defer p.recoverPanic("ValidateActionConfig", &resp.Diagnostics)
 
 	schema := p.GetProviderSchema()
 	if schema.Diagnostics.HasErrors() {
 		resp.Diagnostics = schema.Diagnostics
-		return resp
+		if !p.ContinueOnSchemaError {
+			return resp
+		}
 	}
 
 	actionSchema, ok := schema.Actions[r.TypeName]
 	if !ok {
-		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown resource type %q", r.TypeName))
+		resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("action type", r.TypeName))
 		return resp
 	}
 
-	mp, err := msgpack.Marshal(r.Config, actionSchema.ConfigSchema.ImpliedType())
+	configDV, err := p.encodeDynamicValue(r.Config, actionSchema.ConfigSchema.ImpliedType())
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(err)
 		return resp
@@ -1579,18 +3281,18 @@ func (p *GRPCProvider) ValidateActionConfig(r providers.ValidateActionConfigRequ
 
 	protoReq := &proto.ValidateActionConfig_Request{
 		TypeName: r.TypeName,
-		Config:   &proto.DynamicValue{Msgpack: mp},
+		Config:   configDV,
 	}
 
 	lrs := make([]*proto.LinkedResourceConfig, 0, len(r.LinkedResources))
 	for i, lr := range r.LinkedResources {
 		resourceSchema, ok := schema.ResourceTypes[lr.TypeName]
 		if !ok {
-			resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+			resp.Diagnostics = resp.Diagnostics.Append(p.unknownTypeErr("resource type", lr.TypeName))
 			return resp
 		}
 
-		mp, err := msgpack.Marshal(r.Config, resourceSchema.Body.ImpliedType())
+		lrConfigDV, err := p.encodeDynamicValue(r.Config, resourceSchema.Body.ImpliedType())
 		if err != nil {
 			resp.Diagnostics = resp.Diagnostics.Append(err)
 			return resp
@@ -1598,7 +3300,7 @@ func (p *GRPCProvider) ValidateActionConfig(r providers.ValidateActionConfigRequ
 
 		lrs[i] = &proto.LinkedResourceConfig{
 			TypeName: r.TypeName,
-			Config:   &proto.DynamicValue{Msgpack: mp},
+			Config:   lrConfigDV,
 		}
 	}
 
@@ -1606,9 +3308,10 @@ func (p *GRPCProvider) ValidateActionConfig(r providers.ValidateActionConfigRequ
 		protoReq.LinkedResources = lrs
 	}
 
-	protoResp, err := p.client.ValidateActionConfig(p.ctx, protoReq)
+	ctx := p.rpcContext()
+	protoResp, err := p.client.ValidateActionConfig(ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(ctx, err))
 		return resp
 	}
 
@@ -1616,10 +3319,464 @@ func (p *GRPCProvider) ValidateActionConfig(r providers.ValidateActionConfigRequ
 	return resp
 }
 
+// ValidateActionLinkage checks that every resource type actionType's schema
+// links to actually exists in this provider's schema and declares an
+// identity schema, without making any RPC. Linked resources are addressed
+// and tracked by identity, so a linked resource type missing one would fail
+// confusingly deep inside PlanAction or InvokeAction instead of at the point
+// where the action itself is misconfigured.
+func (p *GRPCProvider) ValidateActionLinkage(actionType string) (diags tfdiags.Diagnostics) {
+	schema := p.GetProviderSchema()
+	if schema.Diagnostics.HasErrors() {
+		diags = schema.Diagnostics
+		if !p.ContinueOnSchemaError {
+			return diags
+		}
+	}
+
+	actionSchema, ok := schema.Actions[actionType]
+	if !ok {
+		diags = diags.Append(p.unknownTypeErr("action type", actionType))
+		return diags
+	}
+
+	for _, lr := range actionSchema.LinkedResources() {
+		resSchema, ok := schema.ResourceTypes[lr.TypeName]
+		if !ok {
+			diags = diags.Append(fmt.Errorf("action %q links to resource type %q, which this provider does not declare", actionType, lr.TypeName))
+			continue
+		}
+		if resSchema.Identity == nil {
+			diags = diags.Append(fmt.Errorf("action %q links to resource type %q, which has no identity schema", actionType, lr.TypeName))
+		}
+	}
+
+	return diags
+}
+
+// requestIDMetadataKey is the outgoing gRPC metadata key under which
+// rpcContext attaches a per-call request ID, so that a provider which echoes
+// it back into its own logs can be correlated with the Terraform call that
+// produced them.
+const requestIDMetadataKey = "tf-req-id"
+
+// requestIDCounter generates the monotonically increasing request IDs
+// rpcContext assigns. It's package-level, rather than per-GRPCProvider,
+// since the IDs only need to be distinct enough for log correlation within
+// a single Terraform run, not globally unique.
+var requestIDCounter atomic.Uint64
+
+// requestIDContextKey is the context.Context key under which rpcContext
+// stores the request ID it generated, so that grpcErr can later recover it
+// without every caller having to thread it through by hand.
+type requestIDContextKey struct{}
+
+// requestIDFromContext returns the request ID rpcContext attached to ctx, or
+// "" if ctx didn't come from rpcContext.
+func requestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey{}).(string)
+	return id
+}
+
+// rpcContext returns the context to use for an outgoing provider RPC. It's
+// p.ctx, with a unique request ID generated and attached to the outgoing
+// gRPC metadata and logged here, so that Terraform's logs and a cooperating
+// provider's own logs can be correlated back to the same call; grpcErr
+// includes the same ID in any error diagnostic it produces. It also has an
+// OpenTelemetry trace context injected into its gRPC metadata if
+// TraceContext is set.
+func (p *GRPCProvider) rpcContext() context.Context {
+	ctx := p.inflightContext()
+
+	id := strconv.FormatUint(requestIDCounter.Add(1), 10)
+	logger.Trace("GRPCProvider: sending request", "request_id", id)
+	ctx = context.WithValue(ctx, requestIDContextKey{}, id)
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	md.Set(requestIDMetadataKey, id)
+
+	if p.TraceContext != nil {
+		otel.GetTextMapPropagator().Inject(p.TraceContext, propagation.HeaderCarrier(http.Header(md)))
+	}
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// inflightContext returns the context every outgoing RPC is derived from,
+// lazily deriving a cancelable child of p.ctx (or of context.Background, for
+// a GRPCProvider constructed without one, such as in tests) the first time
+// it's needed.
+func (p *GRPCProvider) inflightContext() context.Context {
+	p.inflightMu.Lock()
+	defer p.inflightMu.Unlock()
+	if p.inflightCtx == nil {
+		parent := p.ctx
+		if parent == nil {
+			parent = context.Background()
+		}
+		p.inflightCtx, p.inflightCancel = context.WithCancel(parent)
+	}
+	return p.inflightCtx
+}
+
+// CancelInflight cancels every call this provider currently has in flight,
+// including streaming RPCs such as ListResource, without killing the plugin
+// process. Calls already in progress abort with a context-cancelled
+// diagnostic; calls made after CancelInflight returns fail the same way,
+// since the cancellation is permanent. It's meant for wiring up Ctrl-C
+// handling, where core wants the ability to abandon whatever a provider is
+// doing without necessarily tearing down the plugin itself.
+func (p *GRPCProvider) CancelInflight() {
+	p.inflightContext()
+	p.inflightMu.Lock()
+	cancel := p.inflightCancel
+	p.inflightMu.Unlock()
+	cancel()
+}
+
+// acquireConcurrencySlot blocks until a slot is available for typeName under
+// ConcurrencyLimits, or until ctx is done, whichever comes first. The
+// returned release func must be called once the slot is no longer needed;
+// when typeName has no limit configured, acquireConcurrencySlot returns
+// immediately with a no-op release and a nil error.
+func (p *GRPCProvider) acquireConcurrencySlot(ctx context.Context, typeName string) (release func(), err error) {
+	limit, ok := p.ConcurrencyLimits[typeName]
+	if !ok || limit <= 0 {
+		return func() {}, nil
+	}
+
+	p.concurrencySemsMu.Lock()
+	sem, ok := p.concurrencySems[typeName]
+	if !ok {
+		sem = make(chan struct{}, limit)
+		if p.concurrencySems == nil {
+			p.concurrencySems = make(map[string]chan struct{})
+		}
+		p.concurrencySems[typeName] = sem
+	}
+	p.concurrencySemsMu.Unlock()
+
+	select {
+	case sem <- struct{}{}:
+		return func() { <-sem }, nil
+	case <-ctx.Done():
+		return func() {}, ctx.Err()
+	}
+}
+
+// concurrencyLimitDiags builds the diagnostic returned when a call gives up
+// waiting for a ConcurrencyLimits slot because ctx ended first, rather than
+// ever reaching the provider.
+func concurrencyLimitDiags(method, typeName string, err error) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	diags = diags.Append(tfdiags.Sourceless(
+		tfdiags.Error,
+		fmt.Sprintf("Timed out waiting for a concurrency slot in %s", method),
+		fmt.Sprintf("Waiting for a free ConcurrencyLimits slot for resource type %q timed out: %s.", typeName, err),
+	))
+	return diags
+}
+
+// ConnState returns the current state (Ready, Connecting, TransientFailure,
+// Shutdown, etc) of the underlying grpc connection to the provider, for
+// callers that want to report on or debug a flaky plugin. It returns
+// connectivity.Shutdown if this provider wasn't constructed with a
+// connection to observe, such as in-process stub providers.
+func (p *GRPCProvider) ConnState() connectivity.State {
+	if p.conn == nil {
+		return connectivity.Shutdown
+	}
+	return p.conn.GetState()
+}
+
+// rawCallServiceName is the fully qualified gRPC service name RawCall
+// dispatches against, matching the service tfplugin5.proto declares.
+const rawCallServiceName = "tfplugin5.Provider"
+
+// RawCall is a low-level escape hatch that invokes an arbitrary method on
+// the provider's gRPC service by name, bypassing all of the schema-aware
+// marshaling the rest of this file does. It exists so that provider
+// developers experimenting with a new proto RPC can exercise it end to end
+// without waiting for core to grow a typed wrapper for it.
+//
+// method is the short RPC name as declared on the tfplugin5.Provider
+// service, such as "GetSchema" -- not the fully qualified
+// "/tfplugin5.Provider/GetSchema" path. req and resp must be the protobuf
+// message types that RPC expects; RawCall does no validation that they do.
+//
+// This is an advanced, unsupported hook meant for prototyping: it has no
+// panic recovery and no concurrency limiting, and its behavior may change
+// without notice as the plugin protocol evolves.
+func (p *GRPCProvider) RawCall(ctx context.Context, method string, req, resp protobuf.Message) error {
+	if p.conn == nil {
+		return fmt.Errorf("provider %s has no underlying grpc connection to call %q on", p.Addr, method)
+	}
+	return p.conn.Invoke(ctx, fmt.Sprintf("/%s/%s", rawCallServiceName, method), req, resp)
+}
+
+// now returns p.Now() if set, or time.Now otherwise.
+func (p *GRPCProvider) now() time.Time {
+	if p.Now != nil {
+		return p.Now()
+	}
+	return time.Now()
+}
+
+// beforeCall lets p.Interceptor, if set, rewrite the outgoing proto request
+// for method before it's sent. It's a no-op when no Interceptor is set.
+func (p *GRPCProvider) beforeCall(method string, req any) any {
+	if p.Interceptor == nil {
+		return req
+	}
+	if rewritten := p.Interceptor.BeforeCall(method, req); rewritten != nil {
+		return rewritten
+	}
+	return req
+}
+
+// afterCall lets p.Interceptor, if set, observe the proto response for
+// method once the call has returned successfully. It's a no-op when no
+// Interceptor is set.
+func (p *GRPCProvider) afterCall(method string, resp any) {
+	if p.Interceptor != nil {
+		p.Interceptor.AfterCall(method, resp)
+	}
+}
+
+// RecordTo installs an Interceptor on p that writes a JSON log entry for
+// each proto request and response that passes through the Interceptor to w,
+// one JSON object per line. It's meant for capturing real provider traffic
+// to replay as golden test fixtures. Only PlanResourceChange calls pass
+// through the Interceptor, so those are the only calls logged; no other
+// method, streaming or not, is recorded. Any Interceptor already set on p is
+// preserved and still invoked, so RecordTo can be layered onto existing
+// interception without replacing it.
+func (p *GRPCProvider) RecordTo(w io.Writer) {
+	p.Interceptor = &recordingInterceptor{
+		enc:  json.NewEncoder(w),
+		next: p.Interceptor,
+	}
+}
+
+// recordedCall is one line of a RecordTo log: the method called, whether
+// the entry captures the outgoing request or the returned response, and the
+// proto message involved, rendered as protojson so the log is both
+// human-readable and diffable.
+type recordedCall struct {
+	Method string          `json:"method"`
+	Phase  string          `json:"phase"`
+	Proto  json.RawMessage `json:"proto"`
+}
+
+// recordingInterceptor implements Interceptor by writing a JSON log entry
+// for every request and response it observes, then delegating to next, if
+// set, so RecordTo can be layered onto a caller-supplied Interceptor rather
+// than replacing it.
+type recordingInterceptor struct {
+	mu   sync.Mutex
+	enc  *json.Encoder
+	next Interceptor
+}
+
+func (r *recordingInterceptor) BeforeCall(method string, req any) any {
+	r.record(method, "request", req)
+	if r.next != nil {
+		return r.next.BeforeCall(method, req)
+	}
+	return nil
+}
+
+func (r *recordingInterceptor) AfterCall(method string, resp any) {
+	r.record(method, "response", resp)
+	if r.next != nil {
+		r.next.AfterCall(method, resp)
+	}
+}
+
+func (r *recordingInterceptor) record(method, phase string, v any) {
+	msg, ok := v.(protobuf.Message)
+	if !ok {
+		return
+	}
+	proto, err := protojson.Marshal(msg)
+	if err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// Errors from the encoder are deliberately ignored: a recorder writing
+	// to a full disk or closed pipe shouldn't take down provider calls.
+	_ = r.enc.Encode(recordedCall{Method: method, Phase: phase, Proto: proto})
+}
+
+// noopObserveCall is returned by observeCall when no Metrics sink is set, so
+// that call sites can unconditionally defer its result without allocating a
+// closure of their own.
+var noopObserveCall = func(err error) {}
+
+// observeCall starts timing a call to method and returns a closure to defer,
+// which reports the call's duration and outcome to p.Metrics once invoked
+// with the error (if any) the call produced. It's a no-op when no Metrics
+// sink is set.
+func (p *GRPCProvider) observeCall(method string) func(err error) {
+	if p.Metrics == nil {
+		return noopObserveCall
+	}
+	start := p.now()
+	return func(err error) {
+		p.Metrics.ObserveCall(method, p.now().Sub(start), err)
+	}
+}
+
+// recoverPanic recovers a panic occurring anywhere within method, appending
+// an error diagnostic in its place rather than letting the panic propagate
+// and crash the process. A provider that sends a malformed response (for
+// example, an identity with an unexpected shape) can trigger a nil-deref or
+// similar panic deep inside a GRPCProvider method; one bad response
+// shouldn't take down all of Terraform. It must be called directly by a
+// defer statement, as recover only has an effect there.
+func (p *GRPCProvider) recoverPanic(method string, diags *tfdiags.Diagnostics) {
+	if r := recover(); r != nil {
+		*diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			fmt.Sprintf("Plugin panic in %s", method),
+			fmt.Sprintf("The %q plugin panicked while handling %s: %v\n\nThis is always a bug in the plugin or in Terraform's handling of it, and should be reported as such.", p.Addr, method, r),
+		))
+	}
+}
+
+// SawLegacyTypeSystem reports whether any PlanResourceChange or
+// ApplyResourceChange response from this provider instance has set
+// LegacyTypeSystem, meaning the provider is built on the legacy SDK's
+// imprecise type mapping and callers may need to be more lenient with it.
+func (p *GRPCProvider) SawLegacyTypeSystem() bool {
+	return p.sawLegacyTypeSystem.Load()
+}
+
+// recordLegacyTypeSystem latches sawLegacyTypeSystem the first time saw is
+// true, logging once at the transition so operators can spot, from the
+// logs alone, that this provider is on the legacy type system.
+func (p *GRPCProvider) recordLegacyTypeSystem(saw bool) {
+	if saw && p.sawLegacyTypeSystem.CompareAndSwap(false, true) {
+		logger.Debug("GRPCProvider: provider is using the legacy type system", "provider", p.Addr)
+	}
+}
+
+// unknownTypeErr builds the error for an "unknown resource/data source/..."
+// type diagnostic, prefixing it with this provider's source address when
+// Addr is set. In a multi-provider configuration several providers can
+// declare similarly-named types, so the bare type name alone doesn't always
+// say which provider's schema was actually consulted. kind names what was
+// being looked up (e.g. "resource type", "data source", "action type"), so
+// every call site gets the same "unknown <kind> %q" wording instead of each
+// one spelling out its own variant.
+func (p *GRPCProvider) unknownTypeErr(kind, name string) error {
+	msg := fmt.Sprintf("unknown %s %q", kind, name)
+	if p.Addr.IsZero() {
+		return errors.New(msg)
+	}
+	return fmt.Errorf("%s: %s", p.Addr, msg)
+}
+
+// resolveResourceTypeName translates typeName through p.TypeAliases, if an
+// alias is registered for it, so callers are centralized on a single
+// resolution used for both the schema lookup and the TypeName sent to the
+// provider. It's a no-op when no alias is registered for typeName.
+func (p *GRPCProvider) resolveResourceTypeName(typeName string) string {
+	if resolved, ok := p.TypeAliases[typeName]; ok {
+		return resolved
+	}
+	return typeName
+}
+
+// sendMsgSizeCallOptions returns the grpc.CallOption to apply p.MaxSendMsgSize
+// to an outgoing call, or nil if MaxSendMsgSize is unset, in which case gRPC's
+// own default send size limit applies.
+func (p *GRPCProvider) sendMsgSizeCallOptions() []grpc.CallOption {
+	if p.MaxSendMsgSize == 0 {
+		return nil
+	}
+	return []grpc.CallOption{grpc.MaxSendMsgSizeCallOption{MaxSendMsgSize: p.MaxSendMsgSize}}
+}
+
+// minEphemeralRenewalDelay is the smallest gap from now that RenewAt is
+// allowed to resolve to once warnIfRenewAtElapsed has clamped it. It exists
+// so that a provider bug returning an already-elapsed RenewAt can't send
+// the auto-renew machinery into a tight spin of immediate re-renewals.
+const minEphemeralRenewalDelay = 30 * time.Second
+
+// warnIfRenewAtElapsed appends a warning diagnostic if renewAt is non-zero
+// and not after the provider's current time, since a renewal deadline that's
+// already elapsed gives the caller no time to act on it before the
+// ephemeral resource's value is considered stale. It returns a RenewAt clamped to be at least
+// minEphemeralRenewalDelay in the future in that case, leaving renewAt
+// unchanged otherwise.
+func (p *GRPCProvider) warnIfRenewAtElapsed(typeName string, renewAt time.Time, diags tfdiags.Diagnostics) (time.Time, tfdiags.Diagnostics) {
+	if renewAt.IsZero() || renewAt.After(p.now()) {
+		return renewAt, diags
+	}
+	diags = diags.Append(tfdiags.Sourceless(
+		tfdiags.Warning,
+		"Provider returned an already-elapsed renewal deadline",
+		fmt.Sprintf("Provider %q returned a RenewAt for ephemeral resource type %q that is not after the current time. This is a bug in the provider, which should be reported in the provider's own issue tracker.",
+			p.Addr, typeName),
+	))
+	return p.now().Add(minEphemeralRenewalDelay), diags
+}
+
+// ClearValidateCache invalidates any responses memoized by
+// ValidateProviderConfigCache. It's a no-op if no cache is set.
+func (p *GRPCProvider) ClearValidateCache() {
+	if p.ValidateProviderConfigCache != nil {
+		p.ValidateProviderConfigCache.clear()
+	}
+}
+
+// CloseGracefully attempts to close every still-open ephemeral resource in
+// open before killing the plugin, so a slow or forgotten shutdown doesn't
+// orphan cloud resources that needed a closing call to release them. It
+// waits for those closes to finish, or for ctx to be done, whichever comes
+// first, then always proceeds to Close regardless of how it got there: a
+// provider that won't cooperate shouldn't be able to block Terraform from
+// exiting.
+func (p *GRPCProvider) CloseGracefully(ctx context.Context, open []providers.CloseEphemeralResourceRequest) error {
+	logger.Trace("GRPCProvider: CloseGracefully")
+
+	done := make(chan tfdiags.Diagnostics, 1)
+	go func() {
+		done <- p.CloseEphemeralResources(open)
+	}()
+
+	select {
+	case diags := <-done:
+		if diags.HasErrors() {
+			logger.Error("GRPCProvider: CloseGracefully: failed to close one or more ephemeral resources", "error", diags.Err())
+		}
+	case <-ctx.Done():
+		logger.Error("GRPCProvider: CloseGracefully: timed out waiting for ephemeral resources to close", "error", ctx.Err())
+	}
+
+	return p.Close()
+}
+
 // closing the grpc connection is final, and terraform will call it at the end of every phase.
 func (p *GRPCProvider) Close() error {
 	logger.Trace("GRPCProvider: Close")
 
+	if p.DataSourceCache != nil {
+		p.DataSourceCache.clear()
+	}
+	if p.FunctionCache != nil {
+		p.FunctionCache.clear()
+	}
+	p.ClearValidateCache()
+
 	// Make sure to stop the server if we're not running within go-plugin.
 	if p.TestServer != nil {
 		p.TestServer.Stop()
@@ -1635,10 +3792,39 @@ func (p *GRPCProvider) Close() error {
 	}
 
 	p.PluginClient.Kill()
+	if !p.PluginClient.Exited() {
+		err := fmt.Errorf("plugin process for provider %q did not exit cleanly after Kill", p.Addr)
+		logger.Error("GRPCProvider: Close", "error", err)
+		return err
+	}
 	return nil
 }
 
+// firstUnknownValueError walks v and returns an error identifying the first
+// path at which it finds an unknown value, or nil if v is wholly known.
+func firstUnknownValueError(v cty.Value) error {
+	var unknownErr error
+	cty.Walk(v, func(path cty.Path, v cty.Value) (bool, error) {
+		if unknownErr != nil {
+			return false, nil
+		}
+		if !v.IsKnown() {
+			unknownErr = path.NewErrorf("value is unknown")
+			return false, nil
+		}
+		return true, nil
+	})
+	return unknownErr
+}
+
 // Decode a DynamicValue from either the JSON or MsgPack encoding.
+//
+// The msgpack encoding preserves cty value refinements (such as a known
+// non-null unknown value, or a known string prefix) that a provider may
+// attach to an unknown value, for example for a deferred ephemeral resource
+// result. The JSON encoding has no representation for refinements, so any
+// refinements on an unknown value are inherently lost when a provider
+// chooses to respond using JSON instead of msgpack.
 func decodeDynamicValue(v *proto.DynamicValue, ty cty.Type) (cty.Value, error) {
 	// always return a valid value
 	var err error
@@ -1656,6 +3842,340 @@ func decodeDynamicValue(v *proto.DynamicValue, ty cty.Type) (cty.Value, error) {
 	return res, err
 }
 
+// decodeDynamicValueWithLabel is like decodeDynamicValue, but on failure it
+// wraps the error with label and, if the underlying error carries a
+// cty.Path, the path at which conformance with ty failed. Schema-version
+// skew between a provider and core's cached value tends to produce errors
+// like "attribute \"foo\" not expected here", which are much more actionable
+// once they say which value they're about, e.g. "decoding planned state for
+// aws_instance: .foo: attribute \"foo\" not expected here".
+func decodeDynamicValueWithLabel(v *proto.DynamicValue, ty cty.Type, label string) (cty.Value, error) {
+	res, err := decodeDynamicValue(v, ty)
+	if err != nil {
+		err = fmt.Errorf("%s: %s", label, format.ErrorDiag(err))
+	}
+	return res, err
+}
+
+// encodeProviderMeta marshals meta against metaSchema for inclusion in an
+// outgoing proto request's ProviderMeta field. It returns a nil slice,
+// without error, if the provider declares no provider_meta schema, so
+// callers can tell "nothing to send" apart from "sent an encoded null".
+//
+// A cty.NilVal meta (the zero value of cty.Value, as distinct from an
+// explicit null) is normalized to a typed null before marshaling, so every
+// caller gets the same treatment instead of each reimplementing it slightly
+// differently.
+func encodeProviderMeta(metaSchema providers.Schema, meta cty.Value) ([]byte, error) {
+	if metaSchema.Body == nil {
+		return nil, nil
+	}
+
+	ty := metaSchema.Body.ImpliedType()
+	if meta == cty.NilVal {
+		meta = cty.NullVal(ty)
+	}
+	return msgpack.Marshal(meta, ty)
+}
+
+// checkConformance runs a conformance check between v and ty when
+// p.StrictConformance is enabled, appending a diagnostic naming label and
+// pinpointing the offending path for every mismatch found. It's a no-op
+// otherwise, since walking every decoded value against its schema on every
+// call has a real cost that most callers shouldn't pay outside of a
+// provider developer actively debugging schema/value drift.
+func (p *GRPCProvider) checkConformance(label string, v cty.Value, ty cty.Type) tfdiags.Diagnostics {
+	if !p.StrictConformance {
+		return nil
+	}
+
+	var diags tfdiags.Diagnostics
+	for _, err := range v.Type().TestConformance(ty) {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			fmt.Sprintf("Provider returned non-conforming %s", label),
+			format.ErrorDiag(err),
+		))
+	}
+	return diags
+}
+
+// validateActionConfigConformance checks that data conforms to actionType's
+// config schema before it's marshaled onto the wire, so a mismatch is
+// reported as a schema-aware diagnostic naming the action type and the
+// offending attribute path, rather than surfacing as an opaque marshal
+// error.
+func validateActionConfigConformance(actionType string, actionSchema providers.ActionSchema, data cty.Value) error {
+	errs := data.Type().TestConformance(actionSchema.ConfigSchema.ImpliedType())
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid config for action %q: %s", actionType, format.ErrorDiag(errs[0]))
+}
+
+// validateListResourceConfigConformance checks that config conforms to the
+// list resource type's nested config schema before it's marshaled onto the
+// wire, so a mismatch is reported as a schema-aware diagnostic naming the
+// list resource type and the offending attribute path, rather than
+// surfacing as an opaque marshal error.
+func validateListResourceConfigConformance(typeName string, configSchema *configschema.Block, config cty.Value) error {
+	errs := config.Type().TestConformance(configSchema.ImpliedType())
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid config for list resource type %q: %s", typeName, format.ErrorDiag(errs[0]))
+}
+
+// writeOnlyValuePaths returns the paths within v that the schema declares
+// WriteOnly and that actually hold a non-null value. Unlike
+// Block.WriteOnlyPaths, which reports every write-only path regardless of
+// its value, this is for validating results a provider hands back outside
+// of normal planning, such as an imported resource's state, where a
+// non-null write-only value means the provider tried to persist a value
+// core can never read back.
+func writeOnlyValuePaths(body *configschema.Block, v cty.Value) []cty.Path {
+	if body == nil || v == cty.NilVal || v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+
+	paths := body.WriteOnlyPaths(v, nil)
+	if len(paths) == 0 {
+		return nil
+	}
+	pathSet := cty.NewPathSet(paths...)
+
+	var nonNull []cty.Path
+	cty.Walk(v, func(path cty.Path, val cty.Value) (bool, error) {
+		if pathSet.Has(path) && val.IsKnown() && !val.IsNull() {
+			nonNull = append(nonNull, path)
+		}
+		return true, nil
+	})
+	return nonNull
+}
+
+// UnknownComputedPaths walks the schema for typeName and config to
+// enumerate the paths the schema declares as both Optional and Computed
+// that are currently null or unknown. It is a read-only analysis helper
+// for plan tooling that wants to know, ahead of a call to
+// PlanResourceChange, which attributes the provider is free to fill in a
+// default for.
+func (p *GRPCProvider) UnknownComputedPaths(typeName string, config cty.Value) ([]cty.Path, error) {
+	schema := p.GetProviderSchema()
+	if schema.Diagnostics.HasErrors() {
+		return nil, schema.Diagnostics.Err()
+	}
+
+	resourceSchema, ok := schema.ResourceTypes[typeName]
+	if !ok {
+		return nil, p.unknownTypeErr("resource type", typeName)
+	}
+
+	if resourceSchema.Body == nil || config == cty.NilVal || config.IsNull() || !config.IsKnown() {
+		return nil, nil
+	}
+
+	paths := resourceSchema.Body.OptionalComputedPaths(config, nil)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	pathSet := cty.NewPathSet(paths...)
+
+	var unknown []cty.Path
+	cty.Walk(config, func(path cty.Path, val cty.Value) (bool, error) {
+		if pathSet.Has(path) && (!val.IsKnown() || val.IsNull()) {
+			unknown = append(unknown, path)
+		}
+		return true, nil
+	})
+	return unknown, nil
+}
+
+// stripWriteOnlyAttrs nulls out the value at every path the schema flags as
+// WriteOnly, unless allowed is set, in which case v is returned unchanged.
+// It exists for the benefit of older providers, predating the write-only
+// attributes feature, that declare a WriteOnlyAttributesAllowed capability
+// of false: since those providers don't expect write-only values at all,
+// core must not send them on the wire.
+func stripWriteOnlyAttrs(resSchema providers.Schema, v cty.Value, allowed bool) cty.Value {
+	if allowed || resSchema.Body == nil || v == cty.NilVal || v.IsNull() || !v.IsKnown() {
+		return v
+	}
+
+	paths := resSchema.Body.WriteOnlyPaths(v, nil)
+	if len(paths) == 0 {
+		return v
+	}
+	pathSet := cty.NewPathSet(paths...)
+
+	ret, _ := cty.Transform(v, func(path cty.Path, val cty.Value) (cty.Value, error) {
+		if pathSet.Has(path) {
+			return cty.NullVal(val.Type()), nil
+		}
+		return val, nil
+	})
+	return ret
+}
+
+// scrubSensitiveConfigErr redacts any occurrence of a schema-sensitive
+// config value from err's message, returning err unchanged if it doesn't
+// mention any of them. It's used when building diagnostics for
+// ConfigureProvider and ValidateProviderConfig failures, since those errors
+// can otherwise echo the offending config value (for example from a msgpack
+// encoding failure) back into a diagnostic that's displayed to the user or
+// captured in a TF_LOG=trace log.
+func scrubSensitiveConfigErr(resSchema providers.Schema, config cty.Value, err error) error {
+	if err == nil || resSchema.Body == nil || config == cty.NilVal || config.IsNull() || !config.IsWhollyKnown() {
+		return err
+	}
+
+	paths := resSchema.Body.SensitivePaths(config, nil)
+	if len(paths) == 0 {
+		return err
+	}
+
+	msg := err.Error()
+	scrubbed := msg
+	for _, path := range paths {
+		val, pathErr := path.Apply(config)
+		if pathErr != nil || val.IsNull() || !val.IsWhollyKnown() || !val.Type().IsPrimitiveType() {
+			continue
+		}
+		if raw := tfdiags.CompactValueStr(val); raw != "" {
+			scrubbed = strings.ReplaceAll(scrubbed, raw, "(sensitive value)")
+		}
+		if val.Type() == cty.String {
+			scrubbed = strings.ReplaceAll(scrubbed, val.AsString(), "(sensitive value)")
+		}
+	}
+
+	if scrubbed == msg {
+		return err
+	}
+	return errors.New(scrubbed)
+}
+
+// markSensitivePaths applies the Sensitive mark to every path in paths
+// within v. It's used to carry provider-driven sensitivity, as opposed to
+// the sensitivity already implied by the resource's schema, through to the
+// decoded plan.
+func markSensitivePaths(v cty.Value, paths []cty.Path) cty.Value {
+	if len(paths) == 0 {
+		return v
+	}
+	pathSet := cty.NewPathSet(paths...)
+
+	ret, _ := cty.Transform(v, func(path cty.Path, val cty.Value) (cty.Value, error) {
+		if pathSet.Has(path) {
+			return val.Mark(marks.Sensitive), nil
+		}
+		return val, nil
+	})
+	return ret
+}
+
+// resourceTimeout extracts the configured duration for operation (one of
+// "create", "read", "update", "delete") from a resource's "timeouts" nested
+// block in config, falling back to a "default" value if one was set but the
+// specific operation wasn't. It returns ok=false if the schema declares no
+// timeouts block, or the config didn't set a usable value for operation.
+func resourceTimeout(resSchema providers.Schema, config cty.Value, operation string) (d time.Duration, ok bool) {
+	if resSchema.Body == nil || resSchema.Body.BlockTypes["timeouts"] == nil {
+		return 0, false
+	}
+	if config == cty.NilVal || config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute("timeouts") {
+		return 0, false
+	}
+
+	timeouts := config.GetAttr("timeouts")
+	if timeouts.IsNull() || !timeouts.IsKnown() {
+		return 0, false
+	}
+
+	for _, key := range []string{operation, "default"} {
+		if !timeouts.Type().HasAttribute(key) {
+			continue
+		}
+		v := timeouts.GetAttr(key)
+		if v.IsNull() || !v.IsKnown() {
+			continue
+		}
+		d, err := time.ParseDuration(v.AsString())
+		if err != nil {
+			continue
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// planOperation reports which "timeouts" key a PlanResourceChange call
+// corresponds to, matching the legacy SDK's CRUD terminology: deleting when
+// the proposed new state is null, creating when there's no prior state to
+// delete, and otherwise updating.
+func planOperation(priorState, proposedNewState cty.Value) string {
+	switch {
+	case proposedNewState.IsNull():
+		return "delete"
+	case priorState.IsNull():
+		return "create"
+	default:
+		return "update"
+	}
+}
+
+// applyOperation is planOperation's ApplyResourceChange equivalent, using
+// planned state in place of the proposed new state PlanResourceChange sees.
+func applyOperation(priorState, plannedState cty.Value) string {
+	return planOperation(priorState, plannedState)
+}
+
+// timeoutContext derives a context.Context bounded by the resource's
+// configured timeout for operation, if EnforceTimeouts is on and the
+// resource schema and config have one. The returned cancel must be deferred
+// by the caller; it's a no-op when no timeout applies.
+func (p *GRPCProvider) timeoutContext(resSchema providers.Schema, config cty.Value, operation string) (ctx context.Context, limit time.Duration, enforced bool, cancel context.CancelFunc) {
+	ctx = p.rpcContext()
+	if !p.EnforceTimeouts {
+		return ctx, 0, false, func() {}
+	}
+	limit, ok := resourceTimeout(resSchema, config, operation)
+	if !ok {
+		return ctx, 0, false, func() {}
+	}
+	ctx, cancel = context.WithTimeout(ctx, limit)
+	return ctx, limit, true, cancel
+}
+
+// RecommendedTimeouts reports the provider's recommended timeout for each
+// operation ("create", "read", "update", "delete") it advertised one for.
+//
+// The plugin wire protocol has no field for a provider to advertise a
+// recommended timeout independently of a resource's "timeouts" config
+// block; timeouts only ever flow from the practitioner's own configuration,
+// via resourceTimeout and EnforceTimeouts. Until the protocol grows such a
+// field, this always returns an empty map rather than guessing at values a
+// provider never actually sent.
+func (p *GRPCProvider) RecommendedTimeouts() map[string]time.Duration {
+	return map[string]time.Duration{}
+}
+
+// timeoutDiagnostics returns a diagnostic naming operation and limit if err
+// is the result of a GRPCProvider-enforced timeout expiring, or nil
+// otherwise, so callers can surface a specific message instead of the
+// generic one grpcErr would produce for the same underlying gRPC status.
+func timeoutDiagnostics(operation string, limit time.Duration, enforced bool, err error) tfdiags.Diagnostics {
+	if !enforced || status.Code(err) != codes.DeadlineExceeded {
+		return nil
+	}
+	var diags tfdiags.Diagnostics
+	return diags.Append(tfdiags.Sourceless(
+		tfdiags.Error,
+		"Resource operation timed out",
+		fmt.Sprintf("The %s operation did not complete within the configured timeout of %s.", operation, limit),
+	))
+}
+
 func clientCapabilitiesToProto(c providers.ClientCapabilities) *proto.ClientCapabilities {
 	return &proto.ClientCapabilities{
 		DeferralAllowed:            c.DeferralAllowed,
@@ -1663,6 +4183,13 @@ func clientCapabilitiesToProto(c providers.ClientCapabilities) *proto.ClientCapa
 	}
 }
 
+// linkedResourcePlanDataToProto converts lrs to its proto representation,
+// matching each entry against the corresponding linkedResourceSchema entry
+// by position. For an action with no linked resources, both lrs and
+// linkedResourceSchema are expected to have zero length, whether nil or an
+// explicit empty slice; len() treats those identically, so the mismatch
+// check below doesn't need to special-case either one, and the zero-length
+// result is always a non-nil empty slice, never nil.
 func linkedResourcePlanDataToProto(schema providers.GetProviderSchemaResponse, linkedResourceSchema []providers.LinkedResourceSchema, lrs []providers.LinkedResourcePlanData) ([]*proto.PlanAction_Request_LinkedResource, error) {
 	protoLinkedResources := make([]*proto.PlanAction_Request_LinkedResource, 0, len(lrs))
 
@@ -1678,6 +4205,9 @@ func linkedResourcePlanDataToProto(schema providers.GetProviderSchemaResponse, l
 		if !ok {
 			return nil, fmt.Errorf("unknown resource type %q for linked resource #%d", linkedResourceType, i)
 		}
+		if resSchema.Identity == nil {
+			return nil, fmt.Errorf("resource type %q has no identity schema but action linked it", linkedResourceType)
+		}
 
 		priorStateMP, err := msgpack.Marshal(lr.PriorState, resSchema.Body.ImpliedType())
 		if err != nil {
@@ -1724,6 +4254,9 @@ func linkedResourceInvokeDataToProto(schema providers.GetProviderSchemaResponse,
 		if !ok {
 			return nil, fmt.Errorf("unknown resource type %q for linked resource #%d", linkedResourceType, i)
 		}
+		if resSchema.Identity == nil {
+			return nil, fmt.Errorf("resource type %q has no identity schema but action linked it", linkedResourceType)
+		}
 
 		priorStateMP, err := msgpack.Marshal(lr.PriorState, resSchema.Body.ImpliedType())
 		if err != nil {
@@ -1756,13 +4289,14 @@ func linkedResourceInvokeDataToProto(schema providers.GetProviderSchemaResponse,
 }
 
 func protoToLinkedResourcePlans(schema providers.GetProviderSchemaResponse, linkedResourceSchema []providers.LinkedResourceSchema, lrs []*proto.PlanAction_Response_LinkedResource) ([]providers.LinkedResourcePlan, error) {
-
+	var countErr error
 	if len(lrs) != len(linkedResourceSchema) {
-		return nil, fmt.Errorf("mismatched number of linked resources: expected %d, got %d", len(linkedResourceSchema), len(lrs))
+		countErr = linkedResourceCountError(linkedResourceSchema, len(lrs))
 	}
 
-	linkedResources := make([]providers.LinkedResourcePlan, 0, len(lrs))
-	for i, lr := range lrs {
+	matched := min(len(lrs), len(linkedResourceSchema))
+	linkedResources := make([]providers.LinkedResourcePlan, 0, matched)
+	for i, lr := range lrs[:matched] {
 		linkedResourceType := linkedResourceSchema[i].TypeName
 		// Currently we restrict linked resources to be within the same provider,
 		// therefore we can use the schema from the provider to decode the values
@@ -1770,6 +4304,9 @@ func protoToLinkedResourcePlans(schema providers.GetProviderSchemaResponse, link
 		if !ok {
 			return nil, fmt.Errorf("unknown resource type %q for linked resource #%d", linkedResourceType, i)
 		}
+		if resSchema.Identity == nil {
+			return nil, fmt.Errorf("resource type %q has no identity schema but action linked it", linkedResourceType)
+		}
 
 		plannedState, err := decodeDynamicValue(lr.PlannedState, resSchema.Body.ImpliedType())
 		if err != nil {
@@ -1790,17 +4327,18 @@ func protoToLinkedResourcePlans(schema providers.GetProviderSchemaResponse, link
 		})
 	}
 
-	return linkedResources, nil
+	return linkedResources, countErr
 }
 
 func protoToLinkedResourceResults(schema providers.GetProviderSchemaResponse, linkedResourceSchema []providers.LinkedResourceSchema, lrs []*proto.InvokeAction_Event_Completed_LinkedResource) ([]providers.LinkedResourceResult, error) {
-
+	var countErr error
 	if len(lrs) != len(linkedResourceSchema) {
-		return nil, fmt.Errorf("mismatched number of linked resources: expected %d, got %d", len(linkedResourceSchema), len(lrs))
+		countErr = linkedResourceCountError(linkedResourceSchema, len(lrs))
 	}
 
-	linkedResources := make([]providers.LinkedResourceResult, 0, len(lrs))
-	for i, lr := range lrs {
+	matched := min(len(lrs), len(linkedResourceSchema))
+	linkedResources := make([]providers.LinkedResourceResult, 0, matched)
+	for i, lr := range lrs[:matched] {
 		linkedResourceType := linkedResourceSchema[i].TypeName
 		// Currently we restrict linked resources to be within the same provider,
 		// therefore we can use the schema from the provider to decode the values
@@ -1808,6 +4346,9 @@ func protoToLinkedResourceResults(schema providers.GetProviderSchemaResponse, li
 		if !ok {
 			return nil, fmt.Errorf("unknown resource type %q for linked resource #%d", linkedResourceType, i)
 		}
+		if resSchema.Identity == nil {
+			return nil, fmt.Errorf("resource type %q has no identity schema but action linked it", linkedResourceType)
+		}
 
 		newState, err := decodeDynamicValue(lr.NewState, resSchema.Body.ImpliedType())
 		if err != nil {
@@ -1829,5 +4370,28 @@ func protoToLinkedResourceResults(schema providers.GetProviderSchemaResponse, li
 		})
 	}
 
-	return linkedResources, nil
+	return linkedResources, countErr
+}
+
+// linkedResourceCountError builds a diagnostic-friendly error describing a
+// mismatch between the number of linked resources a provider actually
+// returned (got) and the number declared by the action's schema. Unlike a
+// bare "expected N got M" message, it enumerates which linked resources are
+// missing or unexpected so the provider author can tell at a glance which
+// index is wrong.
+func linkedResourceCountError(linkedResourceSchema []providers.LinkedResourceSchema, got int) error {
+	want := len(linkedResourceSchema)
+	if got > want {
+		extra := make([]string, 0, got-want)
+		for i := want; i < got; i++ {
+			extra = append(extra, strconv.Itoa(i))
+		}
+		return fmt.Errorf("provider returned %d linked resources, but only %d were declared; unexpected indices: %s", got, want, strings.Join(extra, ", "))
+	}
+
+	missing := make([]string, 0, want-got)
+	for i := got; i < want; i++ {
+		missing = append(missing, fmt.Sprintf("%d (%s)", i, linkedResourceSchema[i].TypeName))
+	}
+	return fmt.Errorf("provider returned %d linked resources, but %d were declared; missing indices: %s", got, want, strings.Join(missing, ", "))
 }
@@ -6,8 +6,15 @@ package plugin
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -15,15 +22,24 @@ import (
 	"github.com/hashicorp/terraform/internal/addrs"
 	"github.com/hashicorp/terraform/internal/configs/configschema"
 	"github.com/hashicorp/terraform/internal/configs/hcl2shim"
+	"github.com/hashicorp/terraform/internal/lang/marks"
 	"github.com/hashicorp/terraform/internal/plans"
 	"github.com/hashicorp/terraform/internal/providers"
 	"github.com/hashicorp/terraform/internal/schemarepo"
 	"github.com/hashicorp/terraform/internal/tfdiags"
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
 	"github.com/zclconf/go-cty/cty/msgpack"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	protobuf "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/hashicorp/terraform/internal/plugin/convert"
@@ -108,6 +124,18 @@ func providerProtoSchema() *proto.GetProviderSchema_Response {
 					},
 				},
 			},
+			"no_identity_resource": &proto.Schema{
+				Version: 1,
+				Block: &proto.Schema_Block{
+					Attributes: []*proto.Schema_Attribute{
+						{
+							Name:     "attr",
+							Type:     []byte(`"string"`),
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 		DataSourceSchemas: map[string]*proto.Schema{
 			"data": &proto.Schema{
@@ -215,6 +243,42 @@ func providerProtoSchema() *proto.GetProviderSchema_Response {
 					},
 				},
 			},
+
+			"linked_no_identity": {
+				Schema: &proto.Schema{
+					Block: &proto.Schema_Block{
+						Version: 1,
+						Attributes: []*proto.Schema_Attribute{
+							{
+								Name: "attr",
+								Type: []byte(`"string"`),
+							},
+						},
+					},
+				},
+				Type: &proto.ActionSchema_Linked_{
+					Linked: &proto.ActionSchema_Linked{
+						LinkedResources: []*proto.ActionSchema_LinkedResource{
+							{
+								TypeName: "no_identity_resource",
+							},
+						},
+					},
+				},
+			},
+		},
+		Functions: map[string]*proto.Function{
+			"noop": {
+				Parameters: []*proto.Function_Parameter{
+					{
+						Name: "input",
+						Type: []byte(`"string"`),
+					},
+				},
+				Return: &proto.Function_Return{
+					Type: []byte(`"string"`),
+				},
+			},
 		},
 		ServerCapabilities: &proto.ServerCapabilities{
 			GetProviderSchemaOptional: true,
@@ -249,1356 +313,5054 @@ func providerResourceIdentitySchemas() *proto.GetResourceIdentitySchemas_Respons
 	}
 }
 
-func TestGRPCProvider_GetSchema(t *testing.T) {
-	p := &GRPCProvider{
-		client: mockProviderClient(t),
+func TestEncodeProviderMeta(t *testing.T) {
+	metaTy := cty.Object(map[string]cty.Type{"attr": cty.String})
+	metaSchema := providers.Schema{
+		Body: &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"attr": {Type: cty.String, Optional: true},
+			},
+		},
 	}
 
-	resp := p.GetProviderSchema()
-	checkDiags(t, resp.Diagnostics)
-}
-
-// ensure that the global schema cache is used when the provider supports
-// GetProviderSchemaOptional
-func TestGRPCProvider_GetSchema_globalCache(t *testing.T) {
-	p := &GRPCProvider{
-		Addr:   addrs.ImpliedProviderForUnqualifiedType("test"),
-		client: mockProviderClient(t),
-	}
+	t.Run("no provider_meta schema", func(t *testing.T) {
+		mp, err := encodeProviderMeta(providers.Schema{}, cty.NilVal)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mp != nil {
+			t.Fatalf("expected nil, got %q", mp)
+		}
+	})
 
-	// first call primes the cache
-	resp := p.GetProviderSchema()
+	t.Run("NilVal is normalized to a typed null", func(t *testing.T) {
+		mp, err := encodeProviderMeta(metaSchema, cty.NilVal)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := msgpack.Unmarshal(mp, metaTy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.IsNull() {
+			t.Fatalf("expected a null value, got %#v", got)
+		}
+	})
 
-	// create a new provider instance which does not expect a GetProviderSchemaCall
-	p = &GRPCProvider{
-		Addr:   addrs.ImpliedProviderForUnqualifiedType("test"),
-		client: mockproto.NewMockProviderClient(gomock.NewController(t)),
-	}
+	t.Run("explicit null", func(t *testing.T) {
+		mp, err := encodeProviderMeta(metaSchema, cty.NullVal(metaTy))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := msgpack.Unmarshal(mp, metaTy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.IsNull() {
+			t.Fatalf("expected a null value, got %#v", got)
+		}
+	})
 
-	resp = p.GetProviderSchema()
-	checkDiags(t, resp.Diagnostics)
+	t.Run("populated", func(t *testing.T) {
+		meta := cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("foo")})
+		mp, err := encodeProviderMeta(metaSchema, meta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := msgpack.Unmarshal(mp, metaTy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !cmp.Equal(meta, got, typeComparer, valueComparer, equateEmpty) {
+			t.Fatal(cmp.Diff(meta, got, typeComparer, valueComparer, equateEmpty))
+		}
+	})
 }
 
-// Ensure that gRPC errors are returned early.
-// Reference: https://github.com/hashicorp/terraform/issues/31047
-func TestGRPCProvider_GetSchema_GRPCError(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	client := mockproto.NewMockProviderClient(ctrl)
+func TestStripWriteOnlyAttrs(t *testing.T) {
+	resSchema := providers.Schema{
+		Body: &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"attr":     {Type: cty.String, Optional: true},
+				"password": {Type: cty.String, Optional: true, WriteOnly: true},
+			},
+		},
+	}
 
-	client.EXPECT().GetSchema(
-		gomock.Any(),
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.GetProviderSchema_Response{}, fmt.Errorf("test error"))
+	v := cty.ObjectVal(map[string]cty.Value{
+		"attr":     cty.StringVal("foo"),
+		"password": cty.StringVal("secret"),
+	})
 
-	p := &GRPCProvider{
-		client: client,
-	}
+	t.Run("capability off nulls write-only attrs", func(t *testing.T) {
+		got := stripWriteOnlyAttrs(resSchema, v, false)
+		want := cty.ObjectVal(map[string]cty.Value{
+			"attr":     cty.StringVal("foo"),
+			"password": cty.NullVal(cty.String),
+		})
+		if !cmp.Equal(want, got, typeComparer, valueComparer, equateEmpty) {
+			t.Fatal(cmp.Diff(want, got, typeComparer, valueComparer, equateEmpty))
+		}
+	})
 
-	resp := p.GetProviderSchema()
+	t.Run("capability on passes through unchanged", func(t *testing.T) {
+		got := stripWriteOnlyAttrs(resSchema, v, true)
+		if !cmp.Equal(v, got, typeComparer, valueComparer, equateEmpty) {
+			t.Fatal(cmp.Diff(v, got, typeComparer, valueComparer, equateEmpty))
+		}
+	})
 
-	checkDiagsHasError(t, resp.Diagnostics)
+	t.Run("null value is returned unchanged", func(t *testing.T) {
+		null := cty.NullVal(v.Type())
+		got := stripWriteOnlyAttrs(resSchema, null, false)
+		if !cmp.Equal(null, got, typeComparer, valueComparer, equateEmpty) {
+			t.Fatal(cmp.Diff(null, got, typeComparer, valueComparer, equateEmpty))
+		}
+	})
 }
 
-// Ensure that provider error diagnostics are returned early.
-// Reference: https://github.com/hashicorp/terraform/issues/31047
-func TestGRPCProvider_GetSchema_ResponseErrorDiagnostic(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	client := mockproto.NewMockProviderClient(ctrl)
-
-	client.EXPECT().GetSchema(
-		gomock.Any(),
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.GetProviderSchema_Response{
-		Diagnostics: []*proto.Diagnostic{
-			{
-				Severity: proto.Diagnostic_ERROR,
-				Summary:  "error summary",
-				Detail:   "error detail",
+func TestScrubSensitiveConfigErr(t *testing.T) {
+	resSchema := providers.Schema{
+		Body: &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"attr":     {Type: cty.String, Optional: true},
+				"password": {Type: cty.String, Optional: true, Sensitive: true},
 			},
 		},
-		// Trigger potential panics
-		Provider: &proto.Schema{},
-	}, nil)
-
-	p := &GRPCProvider{
-		client: client,
 	}
 
-	resp := p.GetProviderSchema()
+	config := cty.ObjectVal(map[string]cty.Value{
+		"attr":     cty.StringVal("foo"),
+		"password": cty.StringVal("hunter2"),
+	})
 
-	checkDiagsHasError(t, resp.Diagnostics)
+	t.Run("redacts a sensitive value mentioned in the error", func(t *testing.T) {
+		err := fmt.Errorf("could not encode config: invalid value %q for attribute \"password\"", "hunter2")
+		got := scrubSensitiveConfigErr(resSchema, config, err)
+		if strings.Contains(got.Error(), "hunter2") {
+			t.Fatalf("expected sensitive value to be redacted, got: %s", got)
+		}
+		if !strings.Contains(got.Error(), "(sensitive value)") {
+			t.Fatalf("expected redaction placeholder in error, got: %s", got)
+		}
+	})
+
+	t.Run("leaves an error with no sensitive value unchanged", func(t *testing.T) {
+		err := errors.New("could not encode config: invalid value for attribute \"attr\"")
+		got := scrubSensitiveConfigErr(resSchema, config, err)
+		if got != err {
+			t.Fatalf("expected unchanged error, got: %s", got)
+		}
+	})
+
+	t.Run("nil error passes through", func(t *testing.T) {
+		if got := scrubSensitiveConfigErr(resSchema, config, nil); got != nil {
+			t.Fatalf("expected nil, got: %s", got)
+		}
+	})
 }
 
-func TestGRPCProvider_GetSchema_IdentityError(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	client := mockproto.NewMockProviderClient(ctrl)
+func TestGRPCProvider_UnknownComputedPaths(t *testing.T) {
+	resSchema := providers.Schema{
+		Body: &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"required_attr": {Type: cty.String, Required: true},
+				"optional_attr": {Type: cty.String, Optional: true},
+				"computed_attr": {Type: cty.String, Computed: true},
+				"opt_computed":  {Type: cty.String, Optional: true, Computed: true},
+			},
+			BlockTypes: map[string]*configschema.NestedBlock{
+				"nested": {
+					Block: configschema.Block{
+						Attributes: map[string]*configschema.Attribute{
+							"opt_computed": {Type: cty.String, Optional: true, Computed: true},
+						},
+					},
+					Nesting: configschema.NestingSingle,
+				},
+			},
+		},
+	}
 
-	client.EXPECT().GetSchema(
-		gomock.Any(),
-		gomock.Any(),
-		gomock.Any(),
-	).Return(providerProtoSchema(), nil)
+	p := &GRPCProvider{}
+	p.SetSchema(providers.GetProviderSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{"test_thing": resSchema},
+	}, true)
 
-	client.EXPECT().GetResourceIdentitySchemas(
-		gomock.Any(),
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.GetResourceIdentitySchemas_Response{}, fmt.Errorf("test error"))
+	config := cty.ObjectVal(map[string]cty.Value{
+		"required_attr": cty.StringVal("hello"),
+		"optional_attr": cty.StringVal("world"),
+		"computed_attr": cty.UnknownVal(cty.String),
+		"opt_computed":  cty.NullVal(cty.String),
+		"nested": cty.ObjectVal(map[string]cty.Value{
+			"opt_computed": cty.UnknownVal(cty.String),
+		}),
+	})
 
-	p := &GRPCProvider{
-		client: client,
+	got, err := p.UnknownComputedPaths("test_thing", config)
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	resp := p.GetProviderSchema()
+	want := cty.NewPathSet(
+		cty.GetAttrPath("opt_computed"),
+		cty.GetAttrPath("nested").GetAttr("opt_computed"),
+	)
+	gotSet := cty.NewPathSet(got...)
+	if !gotSet.Equal(want) {
+		t.Fatalf("wrong paths\ngot:  %#v\nwant: %#v", got, want)
+	}
 
-	checkDiagsHasError(t, resp.Diagnostics)
+	t.Run("unknown resource type", func(t *testing.T) {
+		_, err := p.UnknownComputedPaths("nonexistent", config)
+		if err == nil {
+			t.Fatal("expected an error for an unknown resource type")
+		}
+	})
 }
 
-func TestGRPCProvider_GetSchema_IdentityUnimplemented(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	client := mockproto.NewMockProviderClient(ctrl)
+func TestResourceTimeout(t *testing.T) {
+	resSchema := providers.Schema{
+		Body: &configschema.Block{
+			BlockTypes: map[string]*configschema.NestedBlock{
+				"timeouts": {
+					Block: configschema.Block{
+						Attributes: map[string]*configschema.Attribute{
+							"create":  {Type: cty.String, Optional: true},
+							"default": {Type: cty.String, Optional: true},
+						},
+					},
+					Nesting: configschema.NestingSingle,
+				},
+			},
+		},
+	}
 
-	client.EXPECT().GetSchema(
-		gomock.Any(),
-		gomock.Any(),
-		gomock.Any(),
-	).Return(providerProtoSchema(), nil)
+	withTimeouts := func(create, deflt string) cty.Value {
+		vals := map[string]cty.Value{}
+		if create != "" {
+			vals["create"] = cty.StringVal(create)
+		} else {
+			vals["create"] = cty.NullVal(cty.String)
+		}
+		if deflt != "" {
+			vals["default"] = cty.StringVal(deflt)
+		} else {
+			vals["default"] = cty.NullVal(cty.String)
+		}
+		return cty.ObjectVal(map[string]cty.Value{
+			"timeouts": cty.ObjectVal(vals),
+		})
+	}
 
-	client.EXPECT().GetResourceIdentitySchemas(
-		gomock.Any(),
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.GetResourceIdentitySchemas_Response{}, status.Error(codes.Unimplemented, "test error"))
+	t.Run("no timeouts block in schema", func(t *testing.T) {
+		_, ok := resourceTimeout(providers.Schema{Body: &configschema.Block{}}, withTimeouts("1m", ""), "create")
+		if ok {
+			t.Fatal("expected no timeout without a schema timeouts block")
+		}
+	})
 
-	p := &GRPCProvider{
-		client: client,
-	}
+	t.Run("operation-specific value", func(t *testing.T) {
+		d, ok := resourceTimeout(resSchema, withTimeouts("90s", "1m"), "create")
+		if !ok {
+			t.Fatal("expected a timeout")
+		}
+		if d != 90*time.Second {
+			t.Fatalf("expected 90s, got %s", d)
+		}
+	})
 
-	resp := p.GetProviderSchema()
+	t.Run("falls back to default", func(t *testing.T) {
+		d, ok := resourceTimeout(resSchema, withTimeouts("", "2m"), "create")
+		if !ok {
+			t.Fatal("expected a timeout")
+		}
+		if d != 2*time.Minute {
+			t.Fatalf("expected 2m, got %s", d)
+		}
+	})
 
-	checkDiags(t, resp.Diagnostics)
+	t.Run("no value set", func(t *testing.T) {
+		_, ok := resourceTimeout(resSchema, withTimeouts("", ""), "create")
+		if ok {
+			t.Fatal("expected no timeout when config sets none")
+		}
+	})
 }
 
-func TestGRPCProvider_GetSchema_IdentityErrorDiagnostic(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	client := mockproto.NewMockProviderClient(ctrl)
+func TestGRPCProvider_ValidateSchema(t *testing.T) {
+	t.Run("valid schema", func(t *testing.T) {
+		client := mockproto.NewMockProviderClient(gomock.NewController(t))
+		client.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(&proto.GetProviderSchema_Response{
+			Provider: &proto.Schema{Block: &proto.Schema_Block{}},
+			ResourceSchemas: map[string]*proto.Schema{
+				"resource": {
+					Block: &proto.Schema_Block{
+						Attributes: []*proto.Schema_Attribute{
+							{Name: "attr", Type: []byte(`"string"`), Optional: true},
+						},
+					},
+				},
+			},
+		}, nil)
+		client.EXPECT().GetResourceIdentitySchemas(gomock.Any(), gomock.Any(), gomock.Any()).Return(&proto.GetResourceIdentitySchemas_Response{}, nil)
 
-	client.EXPECT().GetSchema(
-		gomock.Any(),
-		gomock.Any(),
-		gomock.Any(),
-	).Return(providerProtoSchema(), nil)
+		p := &GRPCProvider{client: client}
+		diags := p.ValidateSchema()
+		checkDiags(t, diags)
+	})
 
-	client.EXPECT().GetResourceIdentitySchemas(
-		gomock.Any(),
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.GetResourceIdentitySchemas_Response{
-		Diagnostics: []*proto.Diagnostic{
-			{
-				Severity: proto.Diagnostic_ERROR,
-				Summary:  "error summary",
-				Detail:   "error detail",
+	t.Run("required and computed attribute", func(t *testing.T) {
+		client := mockproto.NewMockProviderClient(gomock.NewController(t))
+		client.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(&proto.GetProviderSchema_Response{
+			Provider: &proto.Schema{Block: &proto.Schema_Block{}},
+			ResourceSchemas: map[string]*proto.Schema{
+				"resource": {
+					Block: &proto.Schema_Block{
+						Attributes: []*proto.Schema_Attribute{
+							{Name: "attr", Type: []byte(`"string"`), Required: true, Computed: true},
+						},
+					},
+				},
 			},
-		},
-		IdentitySchemas: map[string]*proto.ResourceIdentitySchema{},
-	}, nil)
+		}, nil)
+		client.EXPECT().GetResourceIdentitySchemas(gomock.Any(), gomock.Any(), gomock.Any()).Return(&proto.GetResourceIdentitySchemas_Response{}, nil)
+
+		p := &GRPCProvider{client: client}
+		diags := p.ValidateSchema()
+		checkDiagsHasError(t, diags)
+		got := diags.Err().Error()
+		if !strings.Contains(got, `"resource"`) || !strings.Contains(got, "attr") {
+			t.Fatalf("expected diagnostic to mention the resource type and attribute, got: %s", got)
+		}
+	})
+}
 
-	p := &GRPCProvider{
-		client: client,
+func TestGRPCProvider_ContinueOnSchemaError(t *testing.T) {
+	brokenSchema := providerProtoSchema()
+	brokenSchema.Diagnostics = []*proto.Diagnostic{
+		{
+			Severity: proto.Diagnostic_ERROR,
+			Summary:  "broken schema",
+		},
 	}
 
-	resp := p.GetProviderSchema()
-
-	checkDiagsHasError(t, resp.Diagnostics)
-}
-
-func TestGRPCProvider_GetResourceIdentitySchemas(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	client := mockproto.NewMockProviderClient(ctrl)
+	t.Run("false: short-circuits before attempting the call", func(t *testing.T) {
+		client := mockproto.NewMockProviderClient(gomock.NewController(t))
+		client.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(brokenSchema, nil)
+		// GetProviderSchema returns as soon as the schema's own diagnostics
+		// contain an error, before ever calling GetResourceIdentitySchemas,
+		// so no expectation is set for it here. Likewise, no ReadResource
+		// expectation: the call must never reach the provider.
 
-	client.EXPECT().GetResourceIdentitySchemas(
-		gomock.Any(),
-		gomock.Any(),
-		gomock.Any(),
-	).Return(providerResourceIdentitySchemas(), nil)
+		p := &GRPCProvider{client: client}
+		resp := p.ReadResource(providers.ReadResourceRequest{
+			TypeName: "resource",
+			PriorState: cty.ObjectVal(map[string]cty.Value{
+				"attr": cty.StringVal("foo"),
+			}),
+		})
 
-	p := &GRPCProvider{
-		client: client,
-	}
+		checkDiagsHasError(t, resp.Diagnostics)
+		if !strings.Contains(resp.Diagnostics.Err().Error(), "broken schema") {
+			t.Fatalf("expected the schema's own diagnostic, got: %s", resp.Diagnostics.Err())
+		}
+	})
 
-	resp := p.GetResourceIdentitySchemas()
+	t.Run("true: proceeds past the short-circuit and fails gracefully further in", func(t *testing.T) {
+		client := mockproto.NewMockProviderClient(gomock.NewController(t))
+		client.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(brokenSchema, nil)
+		// GetProviderSchema itself still stops at its own error before
+		// reaching GetResourceIdentitySchemas or populating ResourceTypes,
+		// so there's no RPC left for ReadResource to make; it instead
+		// reports both the schema's error and its own "unknown resource
+		// type" diagnostic, rather than only the first one.
+
+		p := &GRPCProvider{client: client, ContinueOnSchemaError: true}
+		resp := p.ReadResource(providers.ReadResourceRequest{
+			TypeName: "resource",
+			PriorState: cty.ObjectVal(map[string]cty.Value{
+				"attr": cty.StringVal("foo"),
+			}),
+		})
 
-	checkDiags(t, resp.Diagnostics)
+		checkDiagsHasError(t, resp.Diagnostics)
+		got := resp.Diagnostics.Err().Error()
+		if !strings.Contains(got, "broken schema") {
+			t.Fatalf("expected the schema's own diagnostic, got: %s", got)
+		}
+		if !strings.Contains(got, "unknown resource type") {
+			t.Fatalf("expected a second diagnostic from continuing past the schema error, got: %s", got)
+		}
+	})
 }
 
-func TestGRPCProvider_GetResourceIdentitySchemas_Unimplemented(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	client := mockproto.NewMockProviderClient(ctrl)
+func TestGRPCProvider_Functions(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{client: client}
 
-	client.EXPECT().GetResourceIdentitySchemas(
-		gomock.Any(),
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.GetResourceIdentitySchemas_Response{}, status.Error(codes.Unimplemented, "test error"))
+	funcs, diags := p.Functions()
+	checkDiags(t, diags)
 
-	p := &GRPCProvider{
-		client: client,
+	decl, ok := funcs["noop"]
+	if !ok {
+		t.Fatal("expected the catalog to include the provider's \"noop\" function")
 	}
+	if len(decl.Parameters) != 1 || decl.Parameters[0].Name != "input" {
+		t.Fatalf("expected a single \"input\" parameter, got %#v", decl.Parameters)
+	}
+	if decl.ReturnType != cty.String {
+		t.Fatalf("expected a string return type, got %#v", decl.ReturnType)
+	}
+}
 
-	resp := p.GetResourceIdentitySchemas()
-
-	checkDiags(t, resp.Diagnostics)
+func TestGRPCProvider_PlanResourceChange_skipNoop(t *testing.T) {
+	t.Run("no computed attributes: skips the call", func(t *testing.T) {
+		client := mockproto.NewMockProviderClient(gomock.NewController(t))
+		client.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(&proto.GetProviderSchema_Response{
+			Provider: &proto.Schema{Block: &proto.Schema_Block{}},
+			ResourceSchemas: map[string]*proto.Schema{
+				"resource": {
+					Block: &proto.Schema_Block{
+						Attributes: []*proto.Schema_Attribute{
+							{Name: "attr", Type: []byte(`"string"`), Optional: true},
+						},
+					},
+				},
+			},
+		}, nil)
+		client.EXPECT().GetResourceIdentitySchemas(gomock.Any(), gomock.Any(), gomock.Any()).Return(&proto.GetResourceIdentitySchemas_Response{}, nil)
+		// No EXPECT for PlanResourceChange: the call must never reach the provider.
+
+		p := &GRPCProvider{client: client, SkipNoopPlans: true}
+
+		state := cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		})
+		resp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
+			TypeName:         "resource",
+			PriorState:       state,
+			ProposedNewState: state,
+			Config:           state,
+		})
+
+		checkDiags(t, resp.Diagnostics)
+		if !cmp.Equal(state, resp.PlannedState, typeComparer, valueComparer, equateEmpty) {
+			t.Fatal(cmp.Diff(state, resp.PlannedState, typeComparer, valueComparer, equateEmpty))
+		}
+	})
+
+	t.Run("computed attribute present: still calls the provider", func(t *testing.T) {
+		client := mockproto.NewMockProviderClient(gomock.NewController(t))
+		client.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(&proto.GetProviderSchema_Response{
+			Provider: &proto.Schema{Block: &proto.Schema_Block{}},
+			ResourceSchemas: map[string]*proto.Schema{
+				"resource": {
+					Block: &proto.Schema_Block{
+						Attributes: []*proto.Schema_Attribute{
+							{Name: "attr", Type: []byte(`"string"`), Optional: true},
+							{Name: "computed_attr", Type: []byte(`"string"`), Computed: true},
+						},
+					},
+				},
+			},
+		}, nil)
+		client.EXPECT().GetResourceIdentitySchemas(gomock.Any(), gomock.Any(), gomock.Any()).Return(&proto.GetResourceIdentitySchemas_Response{}, nil)
+		client.EXPECT().PlanResourceChange(gomock.Any(), gomock.Any()).Return(&proto.PlanResourceChange_Response{
+			PlannedState: &proto.DynamicValue{
+				Msgpack: []byte("\x82\xa4attr\xa3foo\xadcomputed_attr\xa3bar"),
+			},
+		}, nil)
+
+		p := &GRPCProvider{client: client, SkipNoopPlans: true}
+
+		state := cty.ObjectVal(map[string]cty.Value{
+			"attr":          cty.StringVal("foo"),
+			"computed_attr": cty.StringVal("bar"),
+		})
+		resp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
+			TypeName:         "resource",
+			PriorState:       state,
+			ProposedNewState: state,
+			Config: cty.ObjectVal(map[string]cty.Value{
+				"attr":          cty.StringVal("foo"),
+				"computed_attr": cty.NullVal(cty.String),
+			}),
+		})
+
+		checkDiags(t, resp.Diagnostics)
+	})
 }
 
-func TestGRPCProvider_PrepareProviderConfig(t *testing.T) {
-	client := mockProviderClient(t)
+func TestGRPCProvider_PlanResourceChange_timeout(t *testing.T) {
+	resSchema := providers.Schema{
+		Body: &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"attr": {Type: cty.String, Optional: true},
+			},
+			BlockTypes: map[string]*configschema.NestedBlock{
+				"timeouts": {
+					Block: configschema.Block{
+						Attributes: map[string]*configschema.Attribute{
+							"create": {Type: cty.String, Optional: true},
+						},
+					},
+					Nesting: configschema.NestingSingle,
+				},
+			},
+		},
+	}
+
+	client := mockproto.NewMockProviderClient(gomock.NewController(t))
+	client.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(&proto.GetProviderSchema_Response{
+		Provider: &proto.Schema{Block: &proto.Schema_Block{}},
+		ResourceSchemas: map[string]*proto.Schema{
+			"resource": {
+				Block: &proto.Schema_Block{
+					Attributes: []*proto.Schema_Attribute{
+						{Name: "attr", Type: []byte(`"string"`), Optional: true},
+					},
+					BlockTypes: []*proto.Schema_NestedBlock{
+						{
+							TypeName: "timeouts",
+							Nesting:  proto.Schema_NestedBlock_SINGLE,
+							Block: &proto.Schema_Block{
+								Attributes: []*proto.Schema_Attribute{
+									{Name: "create", Type: []byte(`"string"`), Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	client.EXPECT().GetResourceIdentitySchemas(gomock.Any(), gomock.Any(), gomock.Any()).Return(providerResourceIdentitySchemas(), nil)
+
+	client.EXPECT().PlanResourceChange(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.DeadlineExceeded, "context deadline exceeded"))
+
 	p := &GRPCProvider{
-		client: client,
+		client:          client,
+		EnforceTimeouts: true,
 	}
 
-	client.EXPECT().PrepareProviderConfig(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.PrepareProviderConfig_Response{}, nil)
+	resp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
+		TypeName:   "resource",
+		PriorState: cty.NullVal(resSchema.Body.ImpliedType()),
+		ProposedNewState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+			"timeouts": cty.ObjectVal(map[string]cty.Value{
+				"create": cty.StringVal("1ms"),
+			}),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+			"timeouts": cty.ObjectVal(map[string]cty.Value{
+				"create": cty.StringVal("1ms"),
+			}),
+		}),
+	})
 
-	cfg := hcl2shim.HCL2ValueFromConfigValue(map[string]interface{}{"attr": "value"})
-	resp := p.ValidateProviderConfig(providers.ValidateProviderConfigRequest{Config: cfg})
-	checkDiags(t, resp.Diagnostics)
+	checkDiagsHasError(t, resp.Diagnostics)
+	if !strings.Contains(resp.Diagnostics.Err().Error(), "create") {
+		t.Fatalf("expected diagnostic to name the operation, got %s", resp.Diagnostics.Err())
+	}
 }
 
-func TestGRPCProvider_ValidateResourceConfig(t *testing.T) {
-	client := mockProviderClient(t)
-	p := &GRPCProvider{
-		client: client,
+func TestGRPCProvider_PlanResourceChange_writeOnly(t *testing.T) {
+	protoSchema := &proto.GetProviderSchema_Response{
+		Provider: &proto.Schema{Block: &proto.Schema_Block{}},
+		ResourceSchemas: map[string]*proto.Schema{
+			"resource": {
+				Block: &proto.Schema_Block{
+					Attributes: []*proto.Schema_Attribute{
+						{Name: "attr", Type: []byte(`"string"`), Optional: true},
+						{Name: "password", Type: []byte(`"string"`), Optional: true, WriteOnly: true},
+					},
+				},
+			},
+		},
 	}
 
-	client.EXPECT().ValidateResourceTypeConfig(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.ValidateResourceTypeConfig_Response{}, nil)
+	config := cty.ObjectVal(map[string]cty.Value{
+		"attr":     cty.StringVal("bar"),
+		"password": cty.StringVal("secret"),
+	})
 
-	cfg := hcl2shim.HCL2ValueFromConfigValue(map[string]interface{}{"attr": "value"})
-	resp := p.ValidateResourceConfig(providers.ValidateResourceConfigRequest{
-		TypeName: "resource",
-		Config:   cfg,
+	newPlanRequest := func(writeOnlyAllowed bool) providers.PlanResourceChangeRequest {
+		return providers.PlanResourceChangeRequest{
+			TypeName:           "resource",
+			PriorState:         cty.NullVal(config.Type()),
+			ProposedNewState:   config,
+			Config:             config,
+			ClientCapabilities: providers.ClientCapabilities{WriteOnlyAttributesAllowed: writeOnlyAllowed},
+		}
+	}
+
+	t.Run("capability off strips write-only values before sending", func(t *testing.T) {
+		client := mockproto.NewMockProviderClient(gomock.NewController(t))
+		client.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(protoSchema, nil)
+		client.EXPECT().GetResourceIdentitySchemas(gomock.Any(), gomock.Any(), gomock.Any()).Return(providerResourceIdentitySchemas(), nil)
+
+		var gotConfig *proto.DynamicValue
+		client.EXPECT().PlanResourceChange(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *proto.PlanResourceChange_Request, _ ...grpc.CallOption) (*proto.PlanResourceChange_Response, error) {
+				gotConfig = req.Config
+				return &proto.PlanResourceChange_Response{PlannedState: req.ProposedNewState}, nil
+			})
+
+		p := &GRPCProvider{client: client}
+		resp := p.PlanResourceChange(newPlanRequest(false))
+		checkDiags(t, resp.Diagnostics)
+
+		got, err := decodeDynamicValue(gotConfig, config.Type())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.GetAttr("password").IsNull() {
+			t.Fatalf("expected password to be stripped, got %#v", got.GetAttr("password"))
+		}
+	})
+
+	t.Run("capability on sends write-only values unchanged", func(t *testing.T) {
+		client := mockproto.NewMockProviderClient(gomock.NewController(t))
+		client.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(protoSchema, nil)
+		client.EXPECT().GetResourceIdentitySchemas(gomock.Any(), gomock.Any(), gomock.Any()).Return(providerResourceIdentitySchemas(), nil)
+
+		var gotConfig *proto.DynamicValue
+		client.EXPECT().PlanResourceChange(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *proto.PlanResourceChange_Request, _ ...grpc.CallOption) (*proto.PlanResourceChange_Response, error) {
+				gotConfig = req.Config
+				return &proto.PlanResourceChange_Response{PlannedState: req.ProposedNewState}, nil
+			})
+
+		p := &GRPCProvider{client: client}
+		resp := p.PlanResourceChange(newPlanRequest(true))
+		checkDiags(t, resp.Diagnostics)
+
+		got, err := decodeDynamicValue(gotConfig, config.Type())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.GetAttr("password").AsString() != "secret" {
+			t.Fatalf("expected password to be sent unchanged, got %#v", got.GetAttr("password"))
+		}
 	})
-	checkDiags(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_ValidateDataSourceConfig(t *testing.T) {
-	client := mockProviderClient(t)
+// fakeMetrics is an in-memory Metrics implementation that records every
+// ObserveCall invocation, for tests to assert on.
+type fakeMetrics struct {
+	mu    sync.Mutex
+	calls map[string]int
+	errs  map[string]int
+}
+
+func (m *fakeMetrics) ObserveCall(method string, duration time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.calls == nil {
+		m.calls = make(map[string]int)
+		m.errs = make(map[string]int)
+	}
+	m.calls[method]++
+	if err != nil {
+		m.errs[method]++
+	}
+	if duration < 0 {
+		panic("ObserveCall got a negative duration")
+	}
+}
+
+func TestGRPCProvider_Metrics(t *testing.T) {
+	metrics := &fakeMetrics{}
 	p := &GRPCProvider{
-		client: client,
+		client:  mockProviderClient(t),
+		Metrics: metrics,
 	}
 
-	client.EXPECT().ValidateDataSourceConfig(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.ValidateDataSourceConfig_Response{}, nil)
+	p.GetProviderSchema()
+	p.GetProviderSchema()
+	p.GetProviderSchema()
+	checkDiagsHasError(t, p.ValidateResourceConfig(providers.ValidateResourceConfigRequest{
+		TypeName: "not-a-real-resource",
+		Config:   cty.EmptyObjectVal,
+	}).Diagnostics)
 
-	cfg := hcl2shim.HCL2ValueFromConfigValue(map[string]interface{}{"attr": "value"})
-	resp := p.ValidateDataResourceConfig(providers.ValidateDataResourceConfigRequest{
-		TypeName: "data",
-		Config:   cfg,
-	})
-	checkDiags(t, resp.Diagnostics)
+	// 3 direct calls, plus one made internally by ValidateResourceConfig.
+	if got := metrics.calls["GetProviderSchema"]; got != 4 {
+		t.Fatalf("expected 4 GetProviderSchema observations, got %d", got)
+	}
+	if got := metrics.errs["GetProviderSchema"]; got != 0 {
+		t.Fatalf("expected no GetProviderSchema errors, got %d", got)
+	}
+	if got := metrics.calls["ValidateResourceConfig"]; got != 1 {
+		t.Fatalf("expected 1 ValidateResourceConfig observation, got %d", got)
+	}
+	if got := metrics.errs["ValidateResourceConfig"]; got != 1 {
+		t.Fatalf("expected the ValidateResourceConfig call against an unknown type to be observed as an error, got %d", got)
+	}
 }
 
-func TestGRPCProvider_ValidateListResourceConfig(t *testing.T) {
-	client := mockProviderClient(t)
+func TestGRPCProvider_Metrics_unset(t *testing.T) {
+	// With no Metrics sink configured, calls must still succeed; this just
+	// guards against a nil dereference in observeCall.
 	p := &GRPCProvider{
-		client: client,
+		client: mockProviderClient(t),
 	}
+	checkDiags(t, p.GetProviderSchema().Diagnostics)
+}
 
-	client.EXPECT().ValidateListResourceConfig(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.ValidateListResourceConfig_Response{}, nil)
+func TestGRPCProvider_GetSchema(t *testing.T) {
+	p := &GRPCProvider{
+		client: mockProviderClient(t),
+	}
 
-	cfg := hcl2shim.HCL2ValueFromConfigValue(map[string]interface{}{"config": map[string]interface{}{"filter_attr": "value"}})
-	resp := p.ValidateListResourceConfig(providers.ValidateListResourceConfigRequest{
-		TypeName: "list",
-		Config:   cfg,
-	})
+	resp := p.GetProviderSchema()
 	checkDiags(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_ValidateListResourceConfig_OptionalCfg(t *testing.T) {
+// TestGRPCProvider_ProviderStatus covers providers.GetProviderSchemaResponse.ProviderStatus,
+// both absent and populated.
+func TestGRPCProvider_ProviderStatus(t *testing.T) {
+	t.Run("absent", func(t *testing.T) {
+		// The tfplugin5 wire protocol has no field to carry a provider
+		// status, so a schema fetched over gRPC always comes back with
+		// ProviderStatus left nil.
+		p := &GRPCProvider{
+			client: mockProviderClient(t),
+		}
+
+		resp := p.GetProviderSchema()
+		checkDiags(t, resp.Diagnostics)
+
+		if resp.ProviderStatus != nil {
+			t.Fatalf("expected ProviderStatus to be absent, got %#v", resp.ProviderStatus)
+		}
+	})
+
+	t.Run("populated", func(t *testing.T) {
+		// An in-process provider implementation (not going over the wire)
+		// can still populate this field directly.
+		resp := providers.GetProviderSchemaResponse{
+			ProviderStatus: &providers.ProviderStatus{
+				Level:   providers.ProviderStatusDegraded,
+				Message: "running in limited mode: some resource types are unavailable",
+			},
+		}
+
+		if resp.ProviderStatus.Level != providers.ProviderStatusDegraded {
+			t.Fatalf("expected level %q, got %q", providers.ProviderStatusDegraded, resp.ProviderStatus.Level)
+		}
+		if resp.ProviderStatus.Message == "" {
+			t.Fatal("expected a non-empty message")
+		}
+	})
+}
+
+func TestGRPCProvider_MinTerraformVersion(t *testing.T) {
+	t.Run("absent", func(t *testing.T) {
+		// The tfplugin5 wire protocol has no field to carry a minimum
+		// Terraform version, so a schema fetched over gRPC always comes
+		// back with MinTerraformVersion left empty.
+		p := &GRPCProvider{
+			client: mockProviderClient(t),
+		}
+
+		version, ok := p.MinTerraformVersion()
+		if ok || version != "" {
+			t.Fatalf("expected no minimum version, got %q, %v", version, ok)
+		}
+	})
+
+	t.Run("populated", func(t *testing.T) {
+		// An in-process provider implementation (not going over the wire)
+		// can still populate this field directly.
+		resp := providers.GetProviderSchemaResponse{
+			MinTerraformVersion: "1.9.0",
+		}
+
+		if resp.MinTerraformVersion != "1.9.0" {
+			t.Fatalf("expected minimum version %q, got %q", "1.9.0", resp.MinTerraformVersion)
+		}
+	})
+}
+
+func TestGRPCProvider_GetProviderSchema_PartialIdentityFailure(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	client := mockproto.NewMockProviderClient(ctrl)
-	sch := providerProtoSchema()
-	sch.ListResourceSchemas["list"].Block.Attributes[0].Optional = true
-	sch.ListResourceSchemas["list"].Block.Attributes[0].Required = false
-	// we always need a GetSchema method
+
 	client.EXPECT().GetSchema(
 		gomock.Any(),
 		gomock.Any(),
 		gomock.Any(),
-	).Return(sch, nil)
+	).Return(providerProtoSchema(), nil)
 
-	// GetResourceIdentitySchemas is called as part of GetSchema
+	// The "resource" type's identity schema fails to fetch, but "list"'s
+	// still comes back; the whole call shouldn't bail because of one bad
+	// type.
 	client.EXPECT().GetResourceIdentitySchemas(
 		gomock.Any(),
 		gomock.Any(),
 		gomock.Any(),
-	).Return(providerResourceIdentitySchemas(), nil)
+	).Return(&proto.GetResourceIdentitySchemas_Response{
+		IdentitySchemas: map[string]*proto.ResourceIdentitySchema{
+			"list": {
+				Version: 1,
+				IdentityAttributes: []*proto.ResourceIdentitySchema_IdentityAttribute{
+					{
+						Name:              "id_attr",
+						Type:              []byte(`"string"`),
+						RequiredForImport: true,
+					},
+				},
+			},
+		},
+		Diagnostics: []*proto.Diagnostic{
+			{
+				Severity: proto.Diagnostic_ERROR,
+				Summary:  "Error fetching identity schema",
+				Detail:   "could not build identity schema for \"resource\"",
+			},
+		},
+	}, nil)
+
+	p := &GRPCProvider{client: client}
+
+	resp := p.GetProviderSchema()
+	checkDiags(t, resp.Diagnostics)
+	if !resp.Diagnostics.HasWarnings() {
+		t.Fatal("expected the identity fetch failure to be downgraded to a warning")
+	}
+
+	if len(resp.ResourceTypes) == 0 {
+		t.Fatal("expected ResourceTypes to still be populated")
+	}
+	if resp.ResourceTypes["resource"].Identity != nil {
+		t.Fatal("expected \"resource\" to have no identity schema")
+	}
+	if resp.ResourceTypes["list"].Identity == nil {
+		t.Fatal("expected \"list\" to still have its identity schema")
+	}
+}
 
+func TestGRPCProvider_GetProviderSchemaFor(t *testing.T) {
 	p := &GRPCProvider{
-		client: client,
+		client: mockProviderClient(t),
 	}
-	client.EXPECT().ValidateListResourceConfig(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.ValidateListResourceConfig_Response{}, nil)
 
-	cfg := hcl2shim.HCL2ValueFromConfigValue(map[string]interface{}{})
-	resp := p.ValidateListResourceConfig(providers.ValidateListResourceConfigRequest{
-		TypeName: "list",
-		Config:   cfg,
-	})
+	resp := p.GetProviderSchemaFor(providers.SchemaResources)
 	checkDiags(t, resp.Diagnostics)
+
+	if len(resp.ResourceTypes) == 0 {
+		t.Fatal("expected ResourceTypes to be populated")
+	}
+	if len(resp.DataSources) != 0 {
+		t.Fatal("expected DataSources to be pruned")
+	}
+	if len(resp.Actions) != 0 {
+		t.Fatal("expected Actions to be pruned")
+	}
+	if resp.Functions != nil {
+		t.Fatal("expected Functions to be pruned")
+	}
 }
 
-func TestGRPCProvider_UpgradeResourceState(t *testing.T) {
-	client := mockProviderClient(t)
+func TestGRPCProvider_GetProviderSchemaStream(t *testing.T) {
+	// The tfplugin5 protocol has no chunked/streaming schema RPC, so
+	// GetProviderSchemaStream always falls back to the single-shot
+	// GetProviderSchema; this just confirms that fallback round trips
+	// cleanly rather than exercising any chunk reassembly.
 	p := &GRPCProvider{
-		client: client,
+		client: mockProviderClient(t),
 	}
 
-	client.EXPECT().UpgradeResourceState(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.UpgradeResourceState_Response{
-		UpgradedState: &proto.DynamicValue{
-			Msgpack: []byte("\x81\xa4attr\xa3bar"),
-		},
-	}, nil)
-
-	resp := p.UpgradeResourceState(providers.UpgradeResourceStateRequest{
-		TypeName:     "resource",
-		Version:      0,
-		RawStateJSON: []byte(`{"old_attr":"bar"}`),
-	})
+	resp := p.GetProviderSchemaStream()
 	checkDiags(t, resp.Diagnostics)
 
-	expected := cty.ObjectVal(map[string]cty.Value{
-		"attr": cty.StringVal("bar"),
-	})
-
-	if !cmp.Equal(expected, resp.UpgradedState, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expected, resp.UpgradedState, typeComparer, valueComparer, equateEmpty))
+	if len(resp.ResourceTypes) == 0 {
+		t.Fatal("expected ResourceTypes to be populated")
 	}
 }
 
-func TestGRPCProvider_UpgradeResourceStateJSON(t *testing.T) {
-	client := mockProviderClient(t)
+// TestGRPCProvider_GetProviderSchema_CancelInflight confirms that a schema
+// fetch blocked mid-receive -- for example because the provider is still
+// streaming a very large schema down to the client -- aborts promptly once
+// CancelInflight cancels it, rather than waiting for the rest of the
+// response to arrive.
+func TestGRPCProvider_GetProviderSchema_CancelInflight(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
 	p := &GRPCProvider{
 		client: client,
+		ctx:    context.Background(),
 	}
 
-	client.EXPECT().UpgradeResourceState(
+	started := make(chan struct{})
+	client.EXPECT().GetSchema(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(&proto.UpgradeResourceState_Response{
-		UpgradedState: &proto.DynamicValue{
-			Json: []byte(`{"attr":"bar"}`),
-		},
-	}, nil)
-
-	resp := p.UpgradeResourceState(providers.UpgradeResourceStateRequest{
-		TypeName:     "resource",
-		Version:      0,
-		RawStateJSON: []byte(`{"old_attr":"bar"}`),
+		gomock.Any(),
+	).DoAndReturn(func(ctx context.Context, req *proto.GetProviderSchema_Request, opts ...grpc.CallOption) (*proto.GetProviderSchema_Response, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
 	})
-	checkDiags(t, resp.Diagnostics)
 
-	expected := cty.ObjectVal(map[string]cty.Value{
-		"attr": cty.StringVal("bar"),
-	})
+	done := make(chan providers.GetProviderSchemaResponse, 1)
+	go func() {
+		done <- p.GetProviderSchema()
+	}()
 
-	if !cmp.Equal(expected, resp.UpgradedState, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expected, resp.UpgradedState, typeComparer, valueComparer, equateEmpty))
+	<-started
+	p.CancelInflight()
+
+	select {
+	case resp := <-done:
+		checkDiagsHasError(t, resp.Diagnostics)
+	case <-time.After(5 * time.Second):
+		t.Fatal("GetProviderSchema did not return after CancelInflight")
 	}
 }
 
-func TestGRPCProvider_UpgradeResourceIdentity(t *testing.T) {
-	testCases := []struct {
-		desc          string
-		response      *proto.UpgradeResourceIdentity_Response
-		expectError   bool
-		expectedValue cty.Value
-	}{
-		{
-			"successful upgrade",
-			&proto.UpgradeResourceIdentity_Response{
-				UpgradedIdentity: &proto.ResourceIdentityData{
-					IdentityData: &proto.DynamicValue{
-						Json: []byte(`{"id_attr":"bar"}`),
-					},
-				},
-			},
-			false,
-			cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("bar")}),
-		},
-		{
-			"response with error diagnostic",
-			&proto.UpgradeResourceIdentity_Response{
-				Diagnostics: []*proto.Diagnostic{
-					{
-						Severity: proto.Diagnostic_ERROR,
-						Summary:  "test error",
-						Detail:   "test error detail",
-					},
-				},
-			},
-			true,
-			cty.NilVal,
-		},
-		{
-			"schema mismatch",
-			&proto.UpgradeResourceIdentity_Response{
-				UpgradedIdentity: &proto.ResourceIdentityData{
-					IdentityData: &proto.DynamicValue{
-						Json: []byte(`{"attr_new":"bar"}`),
-					},
-				},
-			},
-			true,
-			cty.NilVal,
-		},
+func TestGRPCProvider_ConnState(t *testing.T) {
+	p := &GRPCProvider{}
+	if got := p.ConnState(); got != connectivity.Shutdown {
+		t.Fatalf("expected Shutdown for a provider with no connection, got %s", got)
 	}
 
-	for _, tc := range testCases {
-		t.Run(tc.desc, func(t *testing.T) {
-			client := mockProviderClient(t)
-			p := &GRPCProvider{
-				client: client,
-			}
-
-			client.EXPECT().UpgradeResourceIdentity(
-				gomock.Any(),
-				gomock.Any(),
-			).Return(tc.response, nil)
-
-			resp := p.UpgradeResourceIdentity(providers.UpgradeResourceIdentityRequest{
-				TypeName:        "resource",
-				Version:         0,
-				RawIdentityJSON: []byte(`{"old_attr":"bar"}`),
-			})
+	fakeListener := bufconn.Listen(1024)
+	srv := grpc.NewServer()
+	proto.RegisterProviderServer(srv, &proto.UnimplementedProviderServer{})
+	go srv.Serve(fakeListener)
+	defer srv.Stop()
 
-			if tc.expectError {
-				checkDiagsHasError(t, resp.Diagnostics)
-			} else {
-				checkDiags(t, resp.Diagnostics)
+	conn, err := grpc.DialContext(
+		context.Background(), "testfake",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return fakeListener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
 
-				if !cmp.Equal(tc.expectedValue, resp.UpgradedIdentity, typeComparer, valueComparer, equateEmpty) {
-					t.Fatal(cmp.Diff(tc.expectedValue, resp.UpgradedIdentity, typeComparer, valueComparer, equateEmpty))
-				}
-			}
-		})
+	p = &GRPCProvider{
+		client: proto.NewProviderClient(conn),
+		conn:   conn,
 	}
-}
 
-func TestGRPCProvider_Configure(t *testing.T) {
-	client := mockProviderClient(t)
-	p := &GRPCProvider{
-		client: client,
+	if _, err := p.client.GetSchema(context.Background(), new(proto.GetProviderSchema_Request)); err == nil {
+		t.Fatal("expected GetSchema against an unimplemented server to fail")
 	}
 
-	client.EXPECT().Configure(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.Configure_Response{}, nil)
+	if got := p.ConnState(); got != connectivity.Ready {
+		t.Fatalf("expected Ready after a successful round trip, got %s", got)
+	}
+}
 
-	resp := p.ConfigureProvider(providers.ConfigureProviderRequest{
-		Config: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
+func TestGRPCProvider_RawCall(t *testing.T) {
+	fakeListener := bufconn.Listen(1024)
+	srv := grpc.NewServer()
+	proto.RegisterProviderServer(srv, &rawCallTestServer{})
+	go srv.Serve(fakeListener)
+	defer srv.Stop()
+
+	conn, err := grpc.DialContext(
+		context.Background(), "testfake",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return fakeListener.DialContext(ctx)
 		}),
-	})
-	checkDiags(t, resp.Diagnostics)
-}
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
 
-func TestGRPCProvider_Stop(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	client := mockproto.NewMockProviderClient(ctrl)
 	p := &GRPCProvider{
-		client: client,
+		client: proto.NewProviderClient(conn),
+		conn:   conn,
 	}
 
-	client.EXPECT().Stop(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.Stop_Response{}, nil)
-
-	err := p.Stop()
-	if err != nil {
+	req := &proto.GetProviderSchema_Request{}
+	resp := &proto.GetProviderSchema_Response{}
+	if err := p.RawCall(context.Background(), "GetSchema", req, resp); err != nil {
 		t.Fatal(err)
 	}
+	if resp.Provider == nil || resp.Provider.Block == nil || len(resp.Provider.Block.Attributes) != 1 {
+		t.Fatalf("unexpected response from raw call: %#v", resp)
+	}
+	if got := resp.Provider.Block.Attributes[0].Name; got != "raw_attr" {
+		t.Fatalf("expected raw_attr, got %q", got)
+	}
 }
 
-func TestGRPCProvider_ReadResource(t *testing.T) {
-	client := mockProviderClient(t)
-	p := &GRPCProvider{
-		client: client,
+func TestGRPCProvider_RawCall_noConn(t *testing.T) {
+	p := &GRPCProvider{}
+	err := p.RawCall(context.Background(), "GetSchema", &proto.GetProviderSchema_Request{}, &proto.GetProviderSchema_Response{})
+	if err == nil {
+		t.Fatal("expected an error for a provider with no underlying connection")
 	}
+}
 
-	client.EXPECT().ReadResource(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.ReadResource_Response{
-		NewState: &proto.DynamicValue{
-			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+// rawCallTestServer implements just enough of proto.ProviderServer to give
+// TestGRPCProvider_RawCall something distinguishable to call through the raw
+// path.
+type rawCallTestServer struct {
+	proto.UnimplementedProviderServer
+}
+
+func (s *rawCallTestServer) GetSchema(context.Context, *proto.GetProviderSchema_Request) (*proto.GetProviderSchema_Response, error) {
+	return &proto.GetProviderSchema_Response{
+		Provider: &proto.Schema{
+			Block: &proto.Schema_Block{
+				Attributes: []*proto.Schema_Attribute{
+					{Name: "raw_attr", Type: []byte(`"string"`), Optional: true},
+				},
+			},
 		},
-	}, nil)
+	}, nil
+}
 
-	resp := p.ReadResource(providers.ReadResourceRequest{
-		TypeName: "resource",
-		PriorState: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
-		}),
-	})
+func TestGRPCProvider_rpcContext(t *testing.T) {
+	base := context.Background()
+	p := &GRPCProvider{ctx: base}
 
-	checkDiags(t, resp.Diagnostics)
+	got := p.rpcContext()
+	md, ok := metadata.FromOutgoingContext(got)
+	if !ok {
+		t.Fatal("expected outgoing gRPC metadata to be set")
+	}
+	ids := md.Get(requestIDMetadataKey)
+	if len(ids) != 1 || ids[0] == "" {
+		t.Fatalf("expected a single non-empty request ID in outgoing metadata, got %v", ids)
+	}
+	if got := requestIDFromContext(got); got != ids[0] {
+		t.Fatalf("expected requestIDFromContext to recover the same ID attached to outgoing metadata, got %q, want %q", got, ids[0])
+	}
 
-	expected := cty.ObjectVal(map[string]cty.Value{
-		"attr": cty.StringVal("bar"),
+	if got2 := p.rpcContext(); requestIDFromContext(got2) == ids[0] {
+		t.Fatal("expected successive calls to rpcContext to generate distinct request IDs")
+	}
+
+	traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	if err != nil {
+		t.Fatal(err)
+	}
+	spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
 	})
+	p.TraceContext = trace.ContextWithSpanContext(context.Background(), sc)
 
-	if !cmp.Equal(expected, resp.NewState, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expected, resp.NewState, typeComparer, valueComparer, equateEmpty))
+	got = p.rpcContext()
+	md, ok = metadata.FromOutgoingContext(got)
+	if !ok {
+		t.Fatal("expected outgoing gRPC metadata to be set")
+	}
+	if vs := md.Get("traceparent"); len(vs) == 0 || !strings.Contains(vs[0], "4bf92f3577b34da6a3ce929d0e0e4736") {
+		t.Fatalf("expected injected traceparent header to carry the trace ID, got %v", vs)
 	}
 }
 
-func TestGRPCProvider_ReadResource_deferred(t *testing.T) {
-	client := mockProviderClient(t)
+func TestGRPCProvider_SetSchema(t *testing.T) {
 	p := &GRPCProvider{
-		client: client,
+		client: mockproto.NewMockProviderClient(gomock.NewController(t)),
 	}
 
-	client.EXPECT().ReadResource(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.ReadResource_Response{
-		NewState: &proto.DynamicValue{
-			Msgpack: []byte("\x81\xa4attr\xa3bar"),
-		},
-		Deferred: &proto.Deferred{
-			Reason: proto.Deferred_ABSENT_PREREQ,
+	injected := providers.GetProviderSchemaResponse{
+		Provider: providers.Schema{
+			Body: &configschema.Block{},
 		},
-	}, nil)
-
-	resp := p.ReadResource(providers.ReadResourceRequest{
-		TypeName: "resource",
-		PriorState: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
-		}),
-	})
+	}
+	p.SetSchema(injected, false)
 
-	checkDiags(t, resp.Diagnostics)
+	resp := p.GetProviderSchema()
+	if !cmp.Equal(injected, resp, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(injected, resp, typeComparer, valueComparer, equateEmpty))
+	}
 
-	expectedDeferred := &providers.Deferred{
-		Reason: providers.DeferredReasonAbsentPrereq,
+	// Without force, a previously-set schema is not overwritten.
+	other := providers.GetProviderSchemaResponse{
+		Provider: providers.Schema{
+			Body: &configschema.Block{},
+		},
+		ServerCapabilities: providers.ServerCapabilities{
+			PlanDestroy: true,
+		},
 	}
-	if !cmp.Equal(expectedDeferred, resp.Deferred, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expectedDeferred, resp.Deferred, typeComparer, valueComparer, equateEmpty))
+	p.SetSchema(other, false)
+	resp = p.GetProviderSchema()
+	if !cmp.Equal(injected, resp, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(injected, resp, typeComparer, valueComparer, equateEmpty))
+	}
+
+	// With force, it is.
+	p.SetSchema(other, true)
+	resp = p.GetProviderSchema()
+	if !cmp.Equal(other, resp, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(other, resp, typeComparer, valueComparer, equateEmpty))
 	}
 }
 
-func TestGRPCProvider_ReadResourceJSON(t *testing.T) {
-	client := mockProviderClient(t)
+// TestGRPCProvider_InvalidateSchema confirms that InvalidateSchema clears
+// both the provider's own cache and its entry in the global
+// providers.SchemaCache, so that a subsequent GetProviderSchema call
+// performs a fresh GetSchema round trip instead of serving a stale result.
+func TestGRPCProvider_InvalidateSchema(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
+	client.EXPECT().GetSchema(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(providerProtoSchema(), nil).Times(2)
+	client.EXPECT().GetResourceIdentitySchemas(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(providerResourceIdentitySchemas(), nil).Times(2)
+
+	addr := addrs.NewDefaultProvider("test")
 	p := &GRPCProvider{
+		Addr:   addr,
 		client: client,
 	}
+	// The global schema cache is a package-level singleton shared with
+	// every other test in this file that uses the same default "test"
+	// address; clear this test's entry so it doesn't leak into them.
+	t.Cleanup(func() { providers.SchemaCache.Remove(addr) })
 
-	client.EXPECT().ReadResource(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.ReadResource_Response{
-		NewState: &proto.DynamicValue{
-			Json: []byte(`{"attr":"bar"}`),
-		},
-	}, nil)
+	checkDiags(t, p.GetProviderSchema().Diagnostics)
+	if _, ok := providers.SchemaCache.Get(addr); !ok {
+		t.Fatal("expected the global schema cache to be populated after GetProviderSchema")
+	}
 
-	resp := p.ReadResource(providers.ReadResourceRequest{
-		TypeName: "resource",
-		PriorState: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
-		}),
-	})
+	p.InvalidateSchema()
+	if _, ok := providers.SchemaCache.Get(addr); ok {
+		t.Fatal("expected InvalidateSchema to remove the global schema cache entry")
+	}
 
-	checkDiags(t, resp.Diagnostics)
+	// This second call must reach the mock again rather than being served
+	// from either cache; gomock fails the test if GetSchema isn't called
+	// exactly twice across the test.
+	checkDiags(t, p.GetProviderSchema().Diagnostics)
+}
 
-	expected := cty.ObjectVal(map[string]cty.Value{
-		"attr": cty.StringVal("bar"),
-	})
+func TestGRPCProvider_SupportedOptionalRPCs(t *testing.T) {
+	p := &GRPCProvider{
+		client: mockProviderClient(t),
+	}
 
-	if !cmp.Equal(expected, resp.NewState, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expected, resp.NewState, typeComparer, valueComparer, equateEmpty))
+	supported := p.SupportedOptionalRPCs()
+
+	want := map[string]bool{
+		"ListResource":                    true,
+		"PlanAction":                      true,
+		"InvokeAction":                    true,
+		"ValidateActionConfig":            true,
+		"ValidateEphemeralResourceConfig": true,
+		"OpenEphemeralResource":           true,
+		"RenewEphemeralResource":          true,
+		"CloseEphemeralResource":          true,
+		"UpgradeResourceIdentity":         true,
+	}
+
+	got := make(map[string]bool, len(supported))
+	for _, rpc := range supported {
+		got[rpc] = true
+	}
+
+	if !cmp.Equal(want, got) {
+		t.Fatal(cmp.Diff(want, got))
 	}
 }
 
-func TestGRPCProvider_ReadEmptyJSON(t *testing.T) {
-	client := mockProviderClient(t)
+func TestGRPCProvider_IdentityCapableTypes(t *testing.T) {
 	p := &GRPCProvider{
-		client: client,
+		client: mockProviderClient(t),
 	}
 
-	client.EXPECT().ReadResource(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.ReadResource_Response{
-		NewState: &proto.DynamicValue{
-			Json: []byte(``),
-		},
-	}, nil)
+	got, diags := p.IdentityCapableTypes()
+	checkDiags(t, diags)
 
-	obj := cty.ObjectVal(map[string]cty.Value{
-		"attr": cty.StringVal("foo"),
-	})
-	resp := p.ReadResource(providers.ReadResourceRequest{
-		TypeName:   "resource",
-		PriorState: obj,
-	})
+	want := []string{"list", "resource"}
+	if !cmp.Equal(want, got) {
+		t.Fatal(cmp.Diff(want, got))
+	}
+}
+
+// ensure that the global schema cache is used when the provider supports
+// GetProviderSchemaOptional
+func TestGRPCProvider_GetSchema_globalCache(t *testing.T) {
+	p := &GRPCProvider{
+		Addr:   addrs.ImpliedProviderForUnqualifiedType("test"),
+		client: mockProviderClient(t),
+	}
+
+	// first call primes the cache
+	resp := p.GetProviderSchema()
+
+	// create a new provider instance which does not expect a GetProviderSchemaCall
+	p = &GRPCProvider{
+		Addr:   addrs.ImpliedProviderForUnqualifiedType("test"),
+		client: mockproto.NewMockProviderClient(gomock.NewController(t)),
+	}
 
+	resp = p.GetProviderSchema()
 	checkDiags(t, resp.Diagnostics)
+}
 
-	expected := cty.NullVal(obj.Type())
+// Ensure that gRPC errors are returned early.
+// Reference: https://github.com/hashicorp/terraform/issues/31047
+func TestGRPCProvider_GetSchema_GRPCError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
 
-	if !cmp.Equal(expected, resp.NewState, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expected, resp.NewState, typeComparer, valueComparer, equateEmpty))
+	client.EXPECT().GetSchema(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.GetProviderSchema_Response{}, fmt.Errorf("test error"))
+
+	p := &GRPCProvider{
+		client: client,
 	}
+
+	resp := p.GetProviderSchema()
+
+	checkDiagsHasError(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_PlanResourceChange(t *testing.T) {
-	client := mockProviderClient(t)
+func TestGRPCProvider_GetSchema_ResourceExhausted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
+
+	client.EXPECT().GetSchema(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.GetProviderSchema_Response{}, status.Error(codes.ResourceExhausted, "received message larger than max"))
+
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	expectedPrivate := []byte(`{"meta": "data"}`)
+	resp := p.GetProviderSchema()
 
-	client.EXPECT().PlanResourceChange(
+	checkDiagsHasError(t, resp.Diagnostics)
+	if got := resp.Diagnostics.Err().Error(); !strings.Contains(got, "too large") {
+		t.Fatalf("expected a schema-too-large diagnostic, got: %s", got)
+	}
+}
+
+// Ensure that provider error diagnostics are returned early.
+// Reference: https://github.com/hashicorp/terraform/issues/31047
+func TestGRPCProvider_GetSchema_ResponseErrorDiagnostic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
+
+	client.EXPECT().GetSchema(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(&proto.PlanResourceChange_Response{
-		PlannedState: &proto.DynamicValue{
-			Msgpack: []byte("\x81\xa4attr\xa3bar"),
-		},
-		RequiresReplace: []*proto.AttributePath{
+		gomock.Any(),
+	).Return(&proto.GetProviderSchema_Response{
+		Diagnostics: []*proto.Diagnostic{
 			{
-				Steps: []*proto.AttributePath_Step{
-					{
-						Selector: &proto.AttributePath_Step_AttributeName{
-							AttributeName: "attr",
-						},
-					},
-				},
+				Severity: proto.Diagnostic_ERROR,
+				Summary:  "error summary",
+				Detail:   "error detail",
 			},
 		},
-		PlannedPrivate: expectedPrivate,
+		// Trigger potential panics
+		Provider: &proto.Schema{},
 	}, nil)
 
-	resp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
-		TypeName: "resource",
-		PriorState: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
-		}),
-		ProposedNewState: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("bar"),
-		}),
-		Config: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("bar"),
-		}),
-	})
+	p := &GRPCProvider{
+		client: client,
+	}
 
-	checkDiags(t, resp.Diagnostics)
+	resp := p.GetProviderSchema()
 
-	expectedState := cty.ObjectVal(map[string]cty.Value{
-		"attr": cty.StringVal("bar"),
-	})
+	checkDiagsHasError(t, resp.Diagnostics)
+}
 
-	if !cmp.Equal(expectedState, resp.PlannedState, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expectedState, resp.PlannedState, typeComparer, valueComparer, equateEmpty))
-	}
+func TestGRPCProvider_GetSchema_IdentityError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
 
-	expectedReplace := `[]cty.Path{cty.Path{cty.GetAttrStep{Name:"attr"}}}`
-	replace := fmt.Sprintf("%#v", resp.RequiresReplace)
-	if expectedReplace != replace {
-		t.Fatalf("expected %q, got %q", expectedReplace, replace)
-	}
+	client.EXPECT().GetSchema(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(providerProtoSchema(), nil)
 
-	if !bytes.Equal(expectedPrivate, resp.PlannedPrivate) {
-		t.Fatalf("expected %q, got %q", expectedPrivate, resp.PlannedPrivate)
+	client.EXPECT().GetResourceIdentitySchemas(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.GetResourceIdentitySchemas_Response{}, fmt.Errorf("test error"))
+
+	p := &GRPCProvider{
+		client: client,
 	}
+
+	resp := p.GetProviderSchema()
+
+	checkDiagsHasError(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_PlanResourceChangeJSON(t *testing.T) {
-	client := mockProviderClient(t)
+func TestGRPCProvider_GetSchema_IdentityUnimplemented(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
+
+	client.EXPECT().GetSchema(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(providerProtoSchema(), nil)
+
+	client.EXPECT().GetResourceIdentitySchemas(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.GetResourceIdentitySchemas_Response{}, status.Error(codes.Unimplemented, "test error"))
+
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	expectedPrivate := []byte(`{"meta": "data"}`)
+	resp := p.GetProviderSchema()
 
-	client.EXPECT().PlanResourceChange(
+	checkDiags(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_GetSchema_IdentityErrorDiagnostic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
+
+	client.EXPECT().GetSchema(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(&proto.PlanResourceChange_Response{
-		PlannedState: &proto.DynamicValue{
-			Json: []byte(`{"attr":"bar"}`),
-		},
-		RequiresReplace: []*proto.AttributePath{
+		gomock.Any(),
+	).Return(providerProtoSchema(), nil)
+
+	client.EXPECT().GetResourceIdentitySchemas(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.GetResourceIdentitySchemas_Response{
+		Diagnostics: []*proto.Diagnostic{
 			{
-				Steps: []*proto.AttributePath_Step{
-					{
-						Selector: &proto.AttributePath_Step_AttributeName{
-							AttributeName: "attr",
-						},
-					},
-				},
+				Severity: proto.Diagnostic_ERROR,
+				Summary:  "error summary",
+				Detail:   "error detail",
 			},
 		},
-		PlannedPrivate: expectedPrivate,
+		IdentitySchemas: map[string]*proto.ResourceIdentitySchema{},
 	}, nil)
 
-	resp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
-		TypeName: "resource",
-		PriorState: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
-		}),
-		ProposedNewState: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("bar"),
-		}),
-		Config: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("bar"),
-		}),
-	})
-
-	checkDiags(t, resp.Diagnostics)
-
-	expectedState := cty.ObjectVal(map[string]cty.Value{
-		"attr": cty.StringVal("bar"),
-	})
-
-	if !cmp.Equal(expectedState, resp.PlannedState, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expectedState, resp.PlannedState, typeComparer, valueComparer, equateEmpty))
+	p := &GRPCProvider{
+		client: client,
 	}
 
-	expectedReplace := `[]cty.Path{cty.Path{cty.GetAttrStep{Name:"attr"}}}`
-	replace := fmt.Sprintf("%#v", resp.RequiresReplace)
-	if expectedReplace != replace {
-		t.Fatalf("expected %q, got %q", expectedReplace, replace)
-	}
+	resp := p.GetProviderSchema()
 
-	if !bytes.Equal(expectedPrivate, resp.PlannedPrivate) {
-		t.Fatalf("expected %q, got %q", expectedPrivate, resp.PlannedPrivate)
-	}
+	checkDiagsHasError(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_ApplyResourceChange(t *testing.T) {
-	client := mockProviderClient(t)
+func TestGRPCProvider_GetResourceIdentitySchemas(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
+
+	client.EXPECT().GetResourceIdentitySchemas(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(providerResourceIdentitySchemas(), nil)
+
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	expectedPrivate := []byte(`{"meta": "data"}`)
-
-	client.EXPECT().ApplyResourceChange(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.ApplyResourceChange_Response{
-		NewState: &proto.DynamicValue{
-			Msgpack: []byte("\x81\xa4attr\xa3bar"),
-		},
-		Private: expectedPrivate,
-	}, nil)
-
-	resp := p.ApplyResourceChange(providers.ApplyResourceChangeRequest{
-		TypeName: "resource",
-		PriorState: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
-		}),
-		PlannedState: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("bar"),
-		}),
-		Config: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("bar"),
-		}),
-		PlannedPrivate: expectedPrivate,
-	})
+	resp := p.GetResourceIdentitySchemas()
 
 	checkDiags(t, resp.Diagnostics)
+}
 
-	expectedState := cty.ObjectVal(map[string]cty.Value{
-		"attr": cty.StringVal("bar"),
-	})
+func TestGRPCProvider_GetResourceIdentitySchemas_Unimplemented(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
 
-	if !cmp.Equal(expectedState, resp.NewState, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expectedState, resp.NewState, typeComparer, valueComparer, equateEmpty))
-	}
+	client.EXPECT().GetResourceIdentitySchemas(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.GetResourceIdentitySchemas_Response{}, status.Error(codes.Unimplemented, "test error"))
 
-	if !bytes.Equal(expectedPrivate, resp.Private) {
-		t.Fatalf("expected %q, got %q", expectedPrivate, resp.Private)
+	p := &GRPCProvider{
+		client: client,
 	}
+
+	resp := p.GetResourceIdentitySchemas()
+
+	checkDiags(t, resp.Diagnostics)
 }
-func TestGRPCProvider_ApplyResourceChangeJSON(t *testing.T) {
+
+func TestGRPCProvider_PrepareProviderConfig(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	expectedPrivate := []byte(`{"meta": "data"}`)
-
-	client.EXPECT().ApplyResourceChange(
+	client.EXPECT().PrepareProviderConfig(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(&proto.ApplyResourceChange_Response{
-		NewState: &proto.DynamicValue{
-			Json: []byte(`{"attr":"bar"}`),
-		},
-		Private: expectedPrivate,
-	}, nil)
-
-	resp := p.ApplyResourceChange(providers.ApplyResourceChangeRequest{
-		TypeName: "resource",
-		PriorState: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
-		}),
-		PlannedState: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("bar"),
-		}),
-		Config: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("bar"),
-		}),
-		PlannedPrivate: expectedPrivate,
-	})
+	).Return(&proto.PrepareProviderConfig_Response{}, nil)
 
+	cfg := hcl2shim.HCL2ValueFromConfigValue(map[string]interface{}{"attr": "value"})
+	resp := p.ValidateProviderConfig(providers.ValidateProviderConfigRequest{Config: cfg})
 	checkDiags(t, resp.Diagnostics)
-
-	expectedState := cty.ObjectVal(map[string]cty.Value{
-		"attr": cty.StringVal("bar"),
-	})
-
-	if !cmp.Equal(expectedState, resp.NewState, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expectedState, resp.NewState, typeComparer, valueComparer, equateEmpty))
-	}
-
-	if !bytes.Equal(expectedPrivate, resp.Private) {
-		t.Fatalf("expected %q, got %q", expectedPrivate, resp.Private)
-	}
 }
 
-func TestGRPCProvider_ImportResourceState(t *testing.T) {
+func TestGRPCProvider_ValidateResourceConfig(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	expectedPrivate := []byte(`{"meta": "data"}`)
-
-	client.EXPECT().ImportResourceState(
+	client.EXPECT().ValidateResourceTypeConfig(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(&proto.ImportResourceState_Response{
-		ImportedResources: []*proto.ImportResourceState_ImportedResource{
-			{
-				TypeName: "resource",
-				State: &proto.DynamicValue{
-					Msgpack: []byte("\x81\xa4attr\xa3bar"),
-				},
-				Private: expectedPrivate,
-			},
-		},
-	}, nil)
+	).Return(&proto.ValidateResourceTypeConfig_Response{}, nil)
 
-	resp := p.ImportResourceState(providers.ImportResourceStateRequest{
+	cfg := hcl2shim.HCL2ValueFromConfigValue(map[string]interface{}{"attr": "value"})
+	resp := p.ValidateResourceConfig(providers.ValidateResourceConfigRequest{
 		TypeName: "resource",
-		ID:       "foo",
+		Config:   cfg,
 	})
-
 	checkDiags(t, resp.Diagnostics)
+}
 
-	expectedResource := providers.ImportedResource{
+func TestGRPCProvider_ValidateResourceConfig_SkipValidateResourceConfig(t *testing.T) {
+	// mockProviderClient isn't used here since there's no EXPECT() call to
+	// set for ValidateResourceTypeConfig: the whole point of the capability
+	// is that the RPC is never made.
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
+
+	p := &GRPCProvider{client: client}
+	p.SetSchema(providers.GetProviderSchemaResponse{
+		Provider: providers.Schema{Body: &configschema.Block{}},
+		ResourceTypes: map[string]providers.Schema{
+			"resource": {Body: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"attr": {Type: cty.String, Optional: true},
+				},
+			}},
+		},
+		ServerCapabilities: providers.ServerCapabilities{
+			SkipValidateResourceConfig: true,
+		},
+	}, true)
+
+	resp := p.ValidateResourceConfig(providers.ValidateResourceConfigRequest{
 		TypeName: "resource",
-		State: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("bar"),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("value"),
 		}),
-		Private: expectedPrivate,
-	}
-
-	imported := resp.ImportedResources[0]
-	if !cmp.Equal(expectedResource, imported, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expectedResource, imported, typeComparer, valueComparer, equateEmpty))
-	}
+	})
+	checkDiags(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_ImportResourceStateJSON(t *testing.T) {
+func TestGRPCProvider_ValidateResourceConfig_preservesRefinements(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	expectedPrivate := []byte(`{"meta": "data"}`)
+	refined := cty.UnknownVal(cty.String).Refine().
+		NotNull().
+		StringPrefix("x").
+		NewValue()
 
-	client.EXPECT().ImportResourceState(
+	var gotConfig *proto.DynamicValue
+	client.EXPECT().ValidateResourceTypeConfig(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(&proto.ImportResourceState_Response{
-		ImportedResources: []*proto.ImportResourceState_ImportedResource{
-			{
-				TypeName: "resource",
-				State: &proto.DynamicValue{
-					Json: []byte(`{"attr":"bar"}`),
-				},
-				Private: expectedPrivate,
-			},
-		},
-	}, nil)
+	).DoAndReturn(func(ctx context.Context, req *proto.ValidateResourceTypeConfig_Request, opts ...grpc.CallOption) (*proto.ValidateResourceTypeConfig_Response, error) {
+		gotConfig = req.Config
+		return &proto.ValidateResourceTypeConfig_Response{}, nil
+	})
 
-	resp := p.ImportResourceState(providers.ImportResourceStateRequest{
+	resp := p.ValidateResourceConfig(providers.ValidateResourceConfigRequest{
 		TypeName: "resource",
-		ID:       "foo",
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": refined,
+		}),
 	})
-
 	checkDiags(t, resp.Diagnostics)
 
-	expectedResource := providers.ImportedResource{
-		TypeName: "resource",
-		State: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("bar"),
-		}),
-		Private: expectedPrivate,
+	got, err := msgpack.Unmarshal(gotConfig.Msgpack, cty.Object(map[string]cty.Type{"attr": cty.String}))
+	if err != nil {
+		t.Fatal(err)
 	}
-
-	imported := resp.ImportedResources[0]
-	if !cmp.Equal(expectedResource, imported, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expectedResource, imported, typeComparer, valueComparer, equateEmpty))
+	attr := got.GetAttr("attr")
+	if attr.IsKnown() {
+		t.Fatal("expected attr to still be unknown on the wire")
+	}
+	if attr.Range().DefinitelyNotNull() != true {
+		t.Fatal("expected the NotNull refinement to be present on the wire")
 	}
 }
 
-func TestGRPCProvider_ImportResourceState_Identity(t *testing.T) {
-	client := mockProviderClient(t)
-	p := &GRPCProvider{
-		client: client,
-	}
+func TestGRPCProvider_OutgoingEncoding(t *testing.T) {
+	cfg := hcl2shim.HCL2ValueFromConfigValue(map[string]interface{}{"attr": "value"})
 
-	client.EXPECT().ImportResourceState(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.ImportResourceState_Response{
-		ImportedResources: []*proto.ImportResourceState_ImportedResource{
-			{
-				TypeName: "resource",
-				State: &proto.DynamicValue{
-					Msgpack: []byte("\x81\xa4attr\xa3bar"),
-				},
-				Identity: &proto.ResourceIdentityData{
-					IdentityData: &proto.DynamicValue{
-						Msgpack: []byte("\x81\xa7id_attr\xa3foo"),
-					},
-				},
-			},
-		},
-	}, nil)
+	t.Run("msgpack is the default", func(t *testing.T) {
+		client := mockProviderClient(t)
+		p := &GRPCProvider{
+			client: client,
+		}
+
+		var gotConfig *proto.DynamicValue
+		client.EXPECT().ValidateResourceTypeConfig(
+			gomock.Any(),
+			gomock.Any(),
+		).DoAndReturn(func(ctx context.Context, req *proto.ValidateResourceTypeConfig_Request, opts ...grpc.CallOption) (*proto.ValidateResourceTypeConfig_Response, error) {
+			gotConfig = req.Config
+			return &proto.ValidateResourceTypeConfig_Response{}, nil
+		})
 
-	resp := p.ImportResourceState(providers.ImportResourceStateRequest{
-		TypeName: "resource",
-		Identity: cty.ObjectVal(map[string]cty.Value{
-			"id_attr": cty.StringVal("foo"),
-		}),
+		resp := p.ValidateResourceConfig(providers.ValidateResourceConfigRequest{
+			TypeName: "resource",
+			Config:   cfg,
+		})
+		checkDiags(t, resp.Diagnostics)
+		if gotConfig.Msgpack == nil {
+			t.Fatal("expected Config to be msgpack-encoded")
+		}
+		if gotConfig.Json != nil {
+			t.Fatal("expected Config to have no JSON encoding")
+		}
 	})
 
-	checkDiags(t, resp.Diagnostics)
-
-	expectedResource := providers.ImportedResource{
-		TypeName: "resource",
-		State: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("bar"),
-		}),
-		Identity: cty.ObjectVal(map[string]cty.Value{
-			"id_attr": cty.StringVal("foo"),
-		}),
-	}
+	t.Run("EncodingJSON switches the wire encoding", func(t *testing.T) {
+		client := mockProviderClient(t)
+		p := &GRPCProvider{
+			client:           client,
+			OutgoingEncoding: EncodingJSON,
+		}
+
+		var gotConfig *proto.DynamicValue
+		client.EXPECT().ValidateResourceTypeConfig(
+			gomock.Any(),
+			gomock.Any(),
+		).DoAndReturn(func(ctx context.Context, req *proto.ValidateResourceTypeConfig_Request, opts ...grpc.CallOption) (*proto.ValidateResourceTypeConfig_Response, error) {
+			gotConfig = req.Config
+			return &proto.ValidateResourceTypeConfig_Response{}, nil
+		})
 
-	imported := resp.ImportedResources[0]
-	if !cmp.Equal(expectedResource, imported, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expectedResource, imported, typeComparer, valueComparer, equateEmpty))
-	}
+		resp := p.ValidateResourceConfig(providers.ValidateResourceConfigRequest{
+			TypeName: "resource",
+			Config:   cfg,
+		})
+		checkDiags(t, resp.Diagnostics)
+		if gotConfig.Json == nil {
+			t.Fatal("expected Config to be JSON-encoded")
+		}
+		if gotConfig.Msgpack != nil {
+			t.Fatal("expected Config to have no msgpack encoding")
+		}
+	})
 }
 
-func TestGRPCProvider_MoveResourceState(t *testing.T) {
+func TestGRPCProvider_ValidateDataSourceConfig(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	expectedTargetPrivate := []byte(`{"target": "private"}`)
-	expectedTargetState := cty.ObjectVal(map[string]cty.Value{
-		"attr": cty.StringVal("bar"),
-	})
-
-	client.EXPECT().MoveResourceState(
+	client.EXPECT().ValidateDataSourceConfig(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(&proto.MoveResourceState_Response{
-		TargetState: &proto.DynamicValue{
-			Msgpack: []byte("\x81\xa4attr\xa3bar"),
-		},
-		TargetPrivate: expectedTargetPrivate,
-	}, nil)
+	).Return(&proto.ValidateDataSourceConfig_Response{}, nil)
 
-	resp := p.MoveResourceState(providers.MoveResourceStateRequest{
-		SourcePrivate:   []byte(`{"source": "private"}`),
-		SourceStateJSON: []byte(`{"source_attr":"bar"}`),
-		TargetTypeName:  "resource",
+	cfg := hcl2shim.HCL2ValueFromConfigValue(map[string]interface{}{"attr": "value"})
+	resp := p.ValidateDataResourceConfig(providers.ValidateDataResourceConfigRequest{
+		TypeName: "data",
+		Config:   cfg,
 	})
-
 	checkDiags(t, resp.Diagnostics)
-
-	if !cmp.Equal(expectedTargetPrivate, resp.TargetPrivate, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expectedTargetPrivate, resp.TargetPrivate, typeComparer, valueComparer, equateEmpty))
-	}
-
-	if !cmp.Equal(expectedTargetState, resp.TargetState, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expectedTargetState, resp.TargetState, typeComparer, valueComparer, equateEmpty))
-	}
 }
 
-func TestGRPCProvider_MoveResourceStateJSON(t *testing.T) {
+func TestGRPCProvider_ValidateListResourceConfig(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	expectedTargetPrivate := []byte(`{"target": "private"}`)
-	expectedTargetState := cty.ObjectVal(map[string]cty.Value{
-		"attr": cty.StringVal("bar"),
-	})
-
-	client.EXPECT().MoveResourceState(
+	client.EXPECT().ValidateListResourceConfig(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(&proto.MoveResourceState_Response{
-		TargetState: &proto.DynamicValue{
-			Json: []byte(`{"attr":"bar"}`),
-		},
-		TargetPrivate: expectedTargetPrivate,
-	}, nil)
+	).Return(&proto.ValidateListResourceConfig_Response{}, nil)
 
-	resp := p.MoveResourceState(providers.MoveResourceStateRequest{
-		SourcePrivate:   []byte(`{"source": "private"}`),
-		SourceStateJSON: []byte(`{"source_attr":"bar"}`),
-		TargetTypeName:  "resource",
+	cfg := hcl2shim.HCL2ValueFromConfigValue(map[string]interface{}{"config": map[string]interface{}{"filter_attr": "value"}})
+	resp := p.ValidateListResourceConfig(providers.ValidateListResourceConfigRequest{
+		TypeName: "list",
+		Config:   cfg,
 	})
+	checkDiags(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_ValidateListResourceConfig_OptionalCfg(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
+	sch := providerProtoSchema()
+	sch.ListResourceSchemas["list"].Block.Attributes[0].Optional = true
+	sch.ListResourceSchemas["list"].Block.Attributes[0].Required = false
+	// we always need a GetSchema method
+	client.EXPECT().GetSchema(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(sch, nil)
+
+	// GetResourceIdentitySchemas is called as part of GetSchema
+	client.EXPECT().GetResourceIdentitySchemas(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(providerResourceIdentitySchemas(), nil)
+
+	p := &GRPCProvider{
+		client: client,
+	}
+	client.EXPECT().ValidateListResourceConfig(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ValidateListResourceConfig_Response{}, nil)
 
+	cfg := hcl2shim.HCL2ValueFromConfigValue(map[string]interface{}{})
+	resp := p.ValidateListResourceConfig(providers.ValidateListResourceConfigRequest{
+		TypeName: "list",
+		Config:   cfg,
+	})
 	checkDiags(t, resp.Diagnostics)
+}
 
-	if !cmp.Equal(expectedTargetPrivate, resp.TargetPrivate, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expectedTargetPrivate, resp.TargetPrivate, typeComparer, valueComparer, equateEmpty))
+func TestGRPCProvider_ValidateListResourceConfig_missingRequiredAttr(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
 	}
 
-	if !cmp.Equal(expectedTargetState, resp.TargetState, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expectedTargetState, resp.TargetState, typeComparer, valueComparer, equateEmpty))
+	// The "list" resource type's nested config schema requires
+	// "filter_attr", so a config missing it should fail conformance before
+	// ever reaching the provider.
+	cfg := hcl2shim.HCL2ValueFromConfigValue(map[string]interface{}{"config": map[string]interface{}{}})
+	resp := p.ValidateListResourceConfig(providers.ValidateListResourceConfigRequest{
+		TypeName: "list",
+		Config:   cfg,
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+	if !strings.Contains(resp.Diagnostics.Err().Error(), "list") {
+		t.Fatalf("expected diagnostics to mention the list resource type, got %s", resp.Diagnostics.Err())
 	}
 }
 
-func TestGRPCProvider_ReadDataSource(t *testing.T) {
+func TestGRPCProvider_UpgradeResourceState(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	client.EXPECT().ReadDataSource(
+	client.EXPECT().UpgradeResourceState(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(&proto.ReadDataSource_Response{
-		State: &proto.DynamicValue{
+	).Return(&proto.UpgradeResourceState_Response{
+		UpgradedState: &proto.DynamicValue{
 			Msgpack: []byte("\x81\xa4attr\xa3bar"),
 		},
 	}, nil)
 
-	resp := p.ReadDataSource(providers.ReadDataSourceRequest{
-		TypeName: "data",
-		Config: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
-		}),
+	resp := p.UpgradeResourceState(providers.UpgradeResourceStateRequest{
+		TypeName:     "resource",
+		Version:      0,
+		RawStateJSON: []byte(`{"old_attr":"bar"}`),
 	})
-
 	checkDiags(t, resp.Diagnostics)
 
 	expected := cty.ObjectVal(map[string]cty.Value{
 		"attr": cty.StringVal("bar"),
 	})
 
-	if !cmp.Equal(expected, resp.State, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expected, resp.State, typeComparer, valueComparer, equateEmpty))
+	if !cmp.Equal(expected, resp.UpgradedState, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expected, resp.UpgradedState, typeComparer, valueComparer, equateEmpty))
 	}
 }
 
-func TestGRPCProvider_ReadDataSourceJSON(t *testing.T) {
+func TestGRPCProvider_UpgradeResourceState_versionDowngrade(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	client.EXPECT().ReadDataSource(
+	client.EXPECT().UpgradeResourceState(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(&proto.ReadDataSource_Response{
-		State: &proto.DynamicValue{
-			Json: []byte(`{"attr":"bar"}`),
+	).Return(&proto.UpgradeResourceState_Response{
+		UpgradedState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
 		},
 	}, nil)
 
-	resp := p.ReadDataSource(providers.ReadDataSourceRequest{
-		TypeName: "data",
-		Config: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
-		}),
-	})
-
-	checkDiags(t, resp.Diagnostics)
-
-	expected := cty.ObjectVal(map[string]cty.Value{
-		"attr": cty.StringVal("bar"),
+	// "resource" is at schema version 1, so asking to upgrade state from
+	// version 2 is a downgrade.
+	resp := p.UpgradeResourceState(providers.UpgradeResourceStateRequest{
+		TypeName:     "resource",
+		Version:      2,
+		RawStateJSON: []byte(`{"old_attr":"bar"}`),
 	})
 
-	if !cmp.Equal(expected, resp.State, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expected, resp.State, typeComparer, valueComparer, equateEmpty))
+	if !resp.Diagnostics.HasWarnings() {
+		t.Fatal("expected a warning diagnostic for a schema version downgrade")
 	}
 }
 
-func TestGRPCProvider_openEphemeralResource(t *testing.T) {
+func TestGRPCProvider_UpgradeResourceStateJSON(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	client.EXPECT().OpenEphemeralResource(
+	client.EXPECT().UpgradeResourceState(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(&proto.OpenEphemeralResource_Response{
-		Result: &proto.DynamicValue{
-			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+	).Return(&proto.UpgradeResourceState_Response{
+		UpgradedState: &proto.DynamicValue{
+			Json: []byte(`{"attr":"bar"}`),
 		},
-		RenewAt: timestamppb.New(time.Now().Add(time.Second)),
-		Private: []byte("private data"),
 	}, nil)
 
-	resp := p.OpenEphemeralResource(providers.OpenEphemeralResourceRequest{
-		TypeName: "ephemeral",
-		Config: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.NullVal(cty.String),
-		}),
+	resp := p.UpgradeResourceState(providers.UpgradeResourceStateRequest{
+		TypeName:     "resource",
+		Version:      0,
+		RawStateJSON: []byte(`{"old_attr":"bar"}`),
 	})
-
 	checkDiags(t, resp.Diagnostics)
 
 	expected := cty.ObjectVal(map[string]cty.Value{
 		"attr": cty.StringVal("bar"),
 	})
 
-	if !cmp.Equal(expected, resp.Result, typeComparer, valueComparer, equateEmpty) {
-		t.Fatal(cmp.Diff(expected, resp.Result, typeComparer, valueComparer, equateEmpty))
-	}
-
-	if !resp.RenewAt.After(time.Now()) {
-		t.Fatal("invalid RenewAt:", resp.RenewAt)
-	}
-
-	if !bytes.Equal(resp.Private, []byte("private data")) {
-		t.Fatalf("invalid private data: %q", resp.Private)
+	if !cmp.Equal(expected, resp.UpgradedState, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expected, resp.UpgradedState, typeComparer, valueComparer, equateEmpty))
 	}
 }
 
-func TestGRPCProvider_renewEphemeralResource(t *testing.T) {
-	client := mockproto.NewMockProviderClient(gomock.NewController(t))
+func TestGRPCProvider_UpgradeResourceStateFlatmapOnly(t *testing.T) {
+	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	client.EXPECT().RenewEphemeralResource(
+	client.EXPECT().UpgradeResourceState(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(&proto.RenewEphemeralResource_Response{
-		RenewAt: timestamppb.New(time.Now().Add(time.Second)),
-		Private: []byte("private data"),
-	}, nil)
-
-	resp := p.RenewEphemeralResource(providers.RenewEphemeralResourceRequest{
-		TypeName: "ephemeral",
-		Private:  []byte("private data"),
+	).DoAndReturn(func(_ context.Context, req *proto.UpgradeResourceState_Request, _ ...grpc.CallOption) (*proto.UpgradeResourceState_Response, error) {
+		if len(req.RawState.Json) != 0 {
+			t.Fatalf("expected no JSON raw state, got %q", req.RawState.Json)
+		}
+		if len(req.RawState.Flatmap) == 0 {
+			t.Fatal("expected flatmap raw state to be populated")
+		}
+		return &proto.UpgradeResourceState_Response{
+			UpgradedState: &proto.DynamicValue{
+				Msgpack: []byte("\x81\xa4attr\xa3bar"),
+			},
+		}, nil
 	})
 
+	resp := p.UpgradeResourceState(providers.UpgradeResourceStateRequest{
+		TypeName:        "resource",
+		Version:         0,
+		RawStateFlatmap: map[string]string{"old_attr": "bar"},
+	})
 	checkDiags(t, resp.Diagnostics)
 
-	if !resp.RenewAt.After(time.Now()) {
-		t.Fatal("invalid RenewAt:", resp.RenewAt)
-	}
+	expected := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
 
-	if !bytes.Equal(resp.Private, []byte("private data")) {
-		t.Fatalf("invalid private data: %q", resp.Private)
+	if !cmp.Equal(expected, resp.UpgradedState, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expected, resp.UpgradedState, typeComparer, valueComparer, equateEmpty))
 	}
 }
 
-func TestGRPCProvider_closeEphemeralResource(t *testing.T) {
-	client := mockproto.NewMockProviderClient(gomock.NewController(t))
+func TestGRPCProvider_UpgradeResourceStateEmpty(t *testing.T) {
+	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	client.EXPECT().CloseEphemeralResource(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.CloseEphemeralResource_Response{}, nil)
-
-	resp := p.CloseEphemeralResource(providers.CloseEphemeralResourceRequest{
-		TypeName: "ephemeral",
-		Private:  []byte("private data"),
+	// No RawState call is expected, since there's nothing to upgrade.
+	resp := p.UpgradeResourceState(providers.UpgradeResourceStateRequest{
+		TypeName: "resource",
+		Version:  0,
 	})
-
-	checkDiags(t, resp.Diagnostics)
+	checkDiagsHasError(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_GetSchema_ListResourceTypes(t *testing.T) {
-	p := &GRPCProvider{
-		client: mockProviderClient(t),
-		ctx:    context.Background(),
-	}
-
-	resp := p.GetProviderSchema()
-	listResourceSchema := resp.ListResourceTypes
-	expected := map[string]providers.Schema{
-		"list": {
-			Version: 1,
-			Body: &configschema.Block{
-				Attributes: map[string]*configschema.Attribute{
-					"data": {
-						Type:     cty.DynamicPseudoType,
-						Computed: true,
+func TestGRPCProvider_UpgradeResourceIdentity(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		response      *proto.UpgradeResourceIdentity_Response
+		expectError   bool
+		expectedValue cty.Value
+	}{
+		{
+			"successful upgrade",
+			&proto.UpgradeResourceIdentity_Response{
+				UpgradedIdentity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Json: []byte(`{"id_attr":"bar"}`),
 					},
 				},
-				BlockTypes: map[string]*configschema.NestedBlock{
-					"config": {
-						Block: configschema.Block{
-							Attributes: map[string]*configschema.Attribute{
-								"filter_attr": {
-									Type:     cty.String,
-									Required: true,
-								},
-							},
-						},
-						Nesting: configschema.NestingSingle,
+			},
+			false,
+			cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("bar")}),
+		},
+		{
+			"response with error diagnostic",
+			&proto.UpgradeResourceIdentity_Response{
+				Diagnostics: []*proto.Diagnostic{
+					{
+						Severity: proto.Diagnostic_ERROR,
+						Summary:  "test error",
+						Detail:   "test error detail",
 					},
 				},
 			},
+			true,
+			cty.NilVal,
 		},
-	}
-	checkDiags(t, resp.Diagnostics)
-
-	actualBody := convert.ConfigSchemaToProto(listResourceSchema["list"].Body).String()
-	expectedBody := convert.ConfigSchemaToProto(expected["list"].Body).String()
-	if actualBody != expectedBody {
-		t.Fatalf("expected %v, got %v", expectedBody, actualBody)
-	}
-}
-
-func TestGRPCProvider_Encode(t *testing.T) {
+		{
+			"schema mismatch",
+			&proto.UpgradeResourceIdentity_Response{
+				UpgradedIdentity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Json: []byte(`{"attr_new":"bar"}`),
+					},
+				},
+			},
+			true,
+			cty.NilVal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			client := mockProviderClient(t)
+			p := &GRPCProvider{
+				client: client,
+			}
+
+			client.EXPECT().UpgradeResourceIdentity(
+				gomock.Any(),
+				gomock.Any(),
+			).Return(tc.response, nil)
+
+			resp := p.UpgradeResourceIdentity(providers.UpgradeResourceIdentityRequest{
+				TypeName:        "resource",
+				Version:         0,
+				RawIdentityJSON: []byte(`{"old_attr":"bar"}`),
+			})
+
+			if tc.expectError {
+				checkDiagsHasError(t, resp.Diagnostics)
+			} else {
+				checkDiags(t, resp.Diagnostics)
+
+				if !cmp.Equal(tc.expectedValue, resp.UpgradedIdentity, typeComparer, valueComparer, equateEmpty) {
+					t.Fatal(cmp.Diff(tc.expectedValue, resp.UpgradedIdentity, typeComparer, valueComparer, equateEmpty))
+				}
+			}
+		})
+	}
+}
+
+func TestGRPCProvider_Configure(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().Configure(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.Configure_Response{}, nil)
+
+	resp := p.ConfigureProvider(providers.ConfigureProviderRequest{
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+	checkDiags(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_Stop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().Stop(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.Stop_Response{}, nil)
+
+	err := p.Stop()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGRPCProvider_StopDiags_providerError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().Stop(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.Stop_Response{Error: "failed to stop"}, nil)
+
+	diags := p.StopDiags()
+	checkDiagsHasError(t, diags)
+	if !strings.Contains(diags.Err().Error(), "failed to stop") {
+		t.Fatalf("expected diagnostics to include the provider's error, got: %s", diags.Err())
+	}
+}
+
+func TestGRPCProvider_StopDiags_transportError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().Stop(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, status.Error(codes.Unavailable, "connection lost"))
+
+	diags := p.StopDiags()
+	checkDiagsHasError(t, diags)
+	if !strings.Contains(diags.Err().Error(), "connection lost") {
+		t.Fatalf("expected diagnostics to include the transport error, got: %s", diags.Err())
+	}
+}
+
+func TestGRPCProvider_ConfigureProvider_configuredMeta(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().Configure(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.Configure_Response{}, nil)
+
+	resp := p.ConfigureProvider(providers.ConfigureProviderRequest{
+		Config: cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("value")}),
+	})
+	checkDiags(t, resp.Diagnostics)
+
+	// The wire protocol doesn't carry this information back yet, so today
+	// ConfiguredMeta and UnavailableFeatures are always nil; this pins that
+	// until Configure.Response grows fields for them.
+	if resp.ConfiguredMeta != nil {
+		t.Fatalf("expected ConfiguredMeta to be nil, got %#v", resp.ConfiguredMeta)
+	}
+	if resp.UnavailableFeatures != nil {
+		t.Fatalf("expected UnavailableFeatures to be nil, got %#v", resp.UnavailableFeatures)
+	}
+}
+
+func TestGRPCProvider_ReadResource(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().ReadResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ReadResource_Response{
+		NewState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+	}, nil)
+
+	resp := p.ReadResource(providers.ReadResourceRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	expected := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+
+	if !cmp.Equal(expected, resp.NewState, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expected, resp.NewState, typeComparer, valueComparer, equateEmpty))
+	}
+}
+
+func TestGRPCProvider_ReadResource_typeAlias(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client:      client,
+		TypeAliases: map[string]string{"old_resource_name": "resource"},
+	}
+
+	var gotTypeName string
+	client.EXPECT().ReadResource(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *proto.ReadResource_Request, _ ...grpc.CallOption) (*proto.ReadResource_Response, error) {
+		gotTypeName = req.TypeName
+		return &proto.ReadResource_Response{
+			NewState: &proto.DynamicValue{
+				Msgpack: []byte("\x81\xa4attr\xa3bar"),
+			},
+		}, nil
+	})
+
+	resp := p.ReadResource(providers.ReadResourceRequest{
+		TypeName: "old_resource_name",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+	if gotTypeName != "resource" {
+		t.Fatalf("expected the request to resolve the alias to %q, got %q", "resource", gotTypeName)
+	}
+}
+
+func TestGRPCProvider_PlanResourceChange_typeAlias(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client:      client,
+		TypeAliases: map[string]string{"old_resource_name": "resource"},
+	}
+
+	var gotTypeName string
+	client.EXPECT().PlanResourceChange(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *proto.PlanResourceChange_Request, _ ...grpc.CallOption) (*proto.PlanResourceChange_Response, error) {
+		gotTypeName = req.TypeName
+		return &proto.PlanResourceChange_Response{
+			PlannedState: &proto.DynamicValue{
+				Msgpack: []byte("\x81\xa4attr\xa3bar"),
+			},
+		}, nil
+	})
+
+	resp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
+		TypeName: "old_resource_name",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		ProposedNewState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+	if gotTypeName != "resource" {
+		t.Fatalf("expected the request to resolve the alias to %q, got %q", "resource", gotTypeName)
+	}
+}
+
+func TestGRPCProvider_ApplyResourceChange_typeAlias(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client:      client,
+		TypeAliases: map[string]string{"old_resource_name": "resource"},
+	}
+
+	var gotTypeName string
+	client.EXPECT().ApplyResourceChange(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *proto.ApplyResourceChange_Request, _ ...grpc.CallOption) (*proto.ApplyResourceChange_Response, error) {
+		gotTypeName = req.TypeName
+		return &proto.ApplyResourceChange_Response{
+			NewState: &proto.DynamicValue{
+				Msgpack: []byte("\x81\xa4attr\xa3bar"),
+			},
+		}, nil
+	})
+
+	resp := p.ApplyResourceChange(providers.ApplyResourceChangeRequest{
+		TypeName: "old_resource_name",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		PlannedState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+	if gotTypeName != "resource" {
+		t.Fatalf("expected the request to resolve the alias to %q, got %q", "resource", gotTypeName)
+	}
+}
+
+func TestGRPCProvider_ReadResource_panicRecovery(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// A provider that returns a nil response alongside a nil error is
+	// malformed, and would otherwise panic with a nil pointer dereference
+	// while reading fields off of it below.
+	client.EXPECT().ReadResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, nil)
+
+	resp := p.ReadResource(providers.ReadResourceRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+	if !strings.Contains(resp.Diagnostics.Err().Error(), "Plugin panic in ReadResource") {
+		t.Fatalf("expected diagnostic to mention the panicking method, got: %s", resp.Diagnostics.Err())
+	}
+}
+
+func TestGRPCProvider_unknownTypeErr_addrPrefix(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+		Addr:   addrs.NewDefaultProvider("test"),
+	}
+
+	resp := p.ReadResource(providers.ReadResourceRequest{
+		TypeName: "nonexistent",
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+	got := resp.Diagnostics.Err().Error()
+	if !strings.Contains(got, p.Addr.String()) {
+		t.Fatalf("expected diagnostic to mention provider address %q, got: %s", p.Addr, got)
+	}
+	if !strings.Contains(got, "unknown resource type") {
+		t.Fatalf("expected diagnostic to mention the unknown type, got: %s", got)
+	}
+}
+
+func TestGRPCProvider_unknownTypeErr_noAddr(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	resp := p.ReadResource(providers.ReadResourceRequest{
+		TypeName: "nonexistent",
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+	got := resp.Diagnostics.Err().Error()
+	if strings.Contains(got, "registry.terraform.io") {
+		t.Fatalf("expected no provider address in diagnostic when Addr is zero, got: %s", got)
+	}
+}
+
+func TestGRPCProvider_unknownTypeErr_wording(t *testing.T) {
+	p := &GRPCProvider{
+		Addr: addrs.NewDefaultProvider("test"),
+	}
+
+	for _, kind := range []string{
+		"resource type",
+		"data source",
+		"action type",
+		"ephemeral resource",
+		"list resource type",
+		"identity type",
+		"resource identity type",
+	} {
+		got := p.unknownTypeErr(kind, "widget").Error()
+		want := fmt.Sprintf("%s: unknown %s %q", p.Addr, kind, "widget")
+		if got != want {
+			t.Fatalf("wrong wording for kind %q\ngot:  %s\nwant: %s", kind, got, want)
+		}
+	}
+}
+
+func TestGRPCProvider_ReadResource_identityWithoutSchema(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// "no_identity_resource" has no identity schema, but the provider
+	// returns identity data for it anyway.
+	client.EXPECT().ReadResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ReadResource_Response{
+		NewState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+		NewIdentity: &proto.ResourceIdentityData{
+			IdentityData: &proto.DynamicValue{
+				Msgpack: []byte("\x81\xa7id_attr\xa2id"),
+			},
+		},
+	}, nil)
+
+	resp := p.ReadResource(providers.ReadResourceRequest{
+		TypeName: "no_identity_resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+	if !strings.Contains(resp.Diagnostics.Err().Error(), "unknown identity type") {
+		t.Fatalf("expected diagnostics to mention unknown identity type, got %s", resp.Diagnostics.Err())
+	}
+}
+
+func TestGRPCProvider_ReadResourceByIdentity(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().ReadResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ReadResource_Response{
+		NewState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+		NewIdentity: &proto.ResourceIdentityData{
+			IdentityData: &proto.DynamicValue{
+				Msgpack: []byte("\x81\xa7id_attr\xa2id"),
+			},
+		},
+	}, nil)
+
+	resp := p.ReadResourceByIdentity(providers.ReadResourceByIdentityRequest{
+		TypeName: "resource",
+		Identity: cty.ObjectVal(map[string]cty.Value{
+			"id_attr": cty.StringVal("id"),
+		}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	expectedState := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+	if !cmp.Equal(expectedState, resp.NewState, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expectedState, resp.NewState, typeComparer, valueComparer, equateEmpty))
+	}
+
+	expectedIdentity := cty.ObjectVal(map[string]cty.Value{
+		"id_attr": cty.StringVal("id"),
+	})
+	if !cmp.Equal(expectedIdentity, resp.Identity, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expectedIdentity, resp.Identity, typeComparer, valueComparer, equateEmpty))
+	}
+}
+
+func TestGRPCProvider_ReadResourceByIdentity_noIdentitySchema(t *testing.T) {
+	p := &GRPCProvider{
+		client: mockProviderClient(t),
+	}
+
+	resp := p.ReadResourceByIdentity(providers.ReadResourceByIdentityRequest{
+		TypeName: "no_identity_resource",
+		Identity: cty.EmptyObjectVal,
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+	if !strings.Contains(resp.Diagnostics.Err().Error(), ErrUnimplemented.Error()) {
+		t.Fatalf("expected diagnostics to mention %q, got %s", ErrUnimplemented, resp.Diagnostics.Err())
+	}
+}
+
+func TestGRPCProvider_ReadResource_deferred(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().ReadResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ReadResource_Response{
+		NewState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+		Deferred: &proto.Deferred{
+			Reason: proto.Deferred_ABSENT_PREREQ,
+		},
+	}, nil)
+
+	resp := p.ReadResource(providers.ReadResourceRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	expectedDeferred := &providers.Deferred{
+		Reason: providers.DeferredReasonAbsentPrereq,
+	}
+	if !cmp.Equal(expectedDeferred, resp.Deferred, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expectedDeferred, resp.Deferred, typeComparer, valueComparer, equateEmpty))
+	}
+}
+
+func TestGRPCProvider_ReadResourceJSON(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().ReadResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ReadResource_Response{
+		NewState: &proto.DynamicValue{
+			Json: []byte(`{"attr":"bar"}`),
+		},
+	}, nil)
+
+	resp := p.ReadResource(providers.ReadResourceRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	expected := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+
+	if !cmp.Equal(expected, resp.NewState, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expected, resp.NewState, typeComparer, valueComparer, equateEmpty))
+	}
+}
+
+func TestGRPCProvider_ReadEmptyJSON(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().ReadResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ReadResource_Response{
+		NewState: &proto.DynamicValue{
+			Json: []byte(``),
+		},
+	}, nil)
+
+	obj := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("foo"),
+	})
+	resp := p.ReadResource(providers.ReadResourceRequest{
+		TypeName:   "resource",
+		PriorState: obj,
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	expected := cty.NullVal(obj.Type())
+
+	if !cmp.Equal(expected, resp.NewState, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expected, resp.NewState, typeComparer, valueComparer, equateEmpty))
+	}
+}
+
+func TestGRPCProvider_PlanResourceChange(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	expectedPrivate := []byte(`{"meta": "data"}`)
+
+	client.EXPECT().PlanResourceChange(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.PlanResourceChange_Response{
+		PlannedState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+		RequiresReplace: []*proto.AttributePath{
+			{
+				Steps: []*proto.AttributePath_Step{
+					{
+						Selector: &proto.AttributePath_Step_AttributeName{
+							AttributeName: "attr",
+						},
+					},
+				},
+			},
+		},
+		PlannedPrivate: expectedPrivate,
+	}, nil)
+
+	resp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		ProposedNewState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	expectedState := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+
+	if !cmp.Equal(expectedState, resp.PlannedState, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expectedState, resp.PlannedState, typeComparer, valueComparer, equateEmpty))
+	}
+
+	expectedReplace := `[]cty.Path{cty.Path{cty.GetAttrStep{Name:"attr"}}}`
+	replace := fmt.Sprintf("%#v", resp.RequiresReplace)
+	if expectedReplace != replace {
+		t.Fatalf("expected %q, got %q", expectedReplace, replace)
+	}
+
+	if !bytes.Equal(expectedPrivate, resp.PlannedPrivate) {
+		t.Fatalf("expected %q, got %q", expectedPrivate, resp.PlannedPrivate)
+	}
+}
+
+func TestGRPCProvider_PlanResourceChange_decodeErrorLabel(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// "resource" only has an "attr" attribute, so a planned state with an
+	// unexpected "wrong" attribute instead doesn't conform to the schema.
+	client.EXPECT().PlanResourceChange(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.PlanResourceChange_Response{
+		PlannedState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa5wrong\xa3bar"),
+		},
+	}, nil)
+
+	resp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		ProposedNewState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+
+	if !strings.Contains(resp.Diagnostics.Err().Error(), "decoding planned state for resource") {
+		t.Fatalf("expected decode error to be labeled with the resource type, got: %s", resp.Diagnostics.Err())
+	}
+}
+
+func TestGRPCProvider_PlanResourceChange_sensitivePaths(t *testing.T) {
+	client := mockproto.NewMockProviderClient(gomock.NewController(t))
+	client.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(&proto.GetProviderSchema_Response{
+		Provider: &proto.Schema{Block: &proto.Schema_Block{}},
+		ResourceSchemas: map[string]*proto.Schema{
+			"resource": {
+				Block: &proto.Schema_Block{
+					Attributes: []*proto.Schema_Attribute{
+						{Name: "attr", Type: []byte(`"string"`), Optional: true},
+					},
+					BlockTypes: []*proto.Schema_NestedBlock{
+						{
+							TypeName: "nested",
+							Nesting:  proto.Schema_NestedBlock_SINGLE,
+							Block: &proto.Schema_Block{
+								Attributes: []*proto.Schema_Attribute{
+									{Name: "secret", Type: []byte(`"string"`), Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	client.EXPECT().GetResourceIdentitySchemas(gomock.Any(), gomock.Any(), gomock.Any()).Return(providerResourceIdentitySchemas(), nil)
+
+	resourceType := cty.Object(map[string]cty.Type{
+		"attr": cty.String,
+		"nested": cty.Object(map[string]cty.Type{
+			"secret": cty.String,
+		}),
+	})
+
+	plannedState := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+		"nested": cty.ObjectVal(map[string]cty.Value{
+			"secret": cty.StringVal("hunter2"),
+		}),
+	})
+	plannedMP, err := msgpack.Marshal(plannedState, resourceType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.EXPECT().PlanResourceChange(gomock.Any(), gomock.Any()).Return(&proto.PlanResourceChange_Response{
+		PlannedState: &proto.DynamicValue{Msgpack: plannedMP},
+		SensitivePaths: []*proto.AttributePath{
+			{
+				Steps: []*proto.AttributePath_Step{
+					{Selector: &proto.AttributePath_Step_AttributeName{AttributeName: "nested"}},
+					{Selector: &proto.AttributePath_Step_AttributeName{AttributeName: "secret"}},
+				},
+			},
+		},
+	}, nil)
+
+	p := &GRPCProvider{client: client}
+
+	resp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
+		TypeName:   "resource",
+		PriorState: cty.NullVal(resourceType),
+		ProposedNewState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+			"nested": cty.ObjectVal(map[string]cty.Value{
+				"secret": cty.StringVal("hunter2"),
+			}),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+			"nested": cty.ObjectVal(map[string]cty.Value{
+				"secret": cty.StringVal("hunter2"),
+			}),
+		}),
+	})
+	checkDiags(t, resp.Diagnostics)
+
+	secret := resp.PlannedState.GetAttr("nested").GetAttr("secret")
+	if !secret.HasMark(marks.Sensitive) {
+		t.Fatalf("expected nested.secret to be marked sensitive, got %#v", secret)
+	}
+	if resp.PlannedState.GetAttr("attr").HasMark(marks.Sensitive) {
+		t.Fatal("expected attr to be unmarked")
+	}
+}
+
+type testInterceptor struct {
+	beforeCalls []string
+	afterCalls  []string
+}
+
+func (i *testInterceptor) BeforeCall(method string, req any) any {
+	i.beforeCalls = append(i.beforeCalls, method)
+	planReq, ok := req.(*proto.PlanResourceChange_Request)
+	if !ok {
+		return nil
+	}
+	rewritten := protobuf.Clone(planReq).(*proto.PlanResourceChange_Request)
+	rewritten.PriorPrivate = []byte("rewritten by interceptor")
+	return rewritten
+}
+
+func (i *testInterceptor) AfterCall(method string, resp any) {
+	i.afterCalls = append(i.afterCalls, method)
+}
+
+func TestGRPCProvider_PlanResourceChange_interceptor(t *testing.T) {
+	client := mockProviderClient(t)
+	interceptor := &testInterceptor{}
+	p := &GRPCProvider{
+		client:      client,
+		Interceptor: interceptor,
+	}
+
+	var gotPriorPrivate []byte
+	client.EXPECT().PlanResourceChange(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *proto.PlanResourceChange_Request, _ ...grpc.CallOption) (*proto.PlanResourceChange_Response, error) {
+		gotPriorPrivate = req.PriorPrivate
+		return &proto.PlanResourceChange_Response{
+			PlannedState: &proto.DynamicValue{
+				Msgpack: []byte("\x81\xa4attr\xa3bar"),
+			},
+		}, nil
+	})
+
+	resp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		ProposedNewState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	if string(gotPriorPrivate) != "rewritten by interceptor" {
+		t.Fatalf("expected the interceptor to rewrite PriorPrivate, got %q", gotPriorPrivate)
+	}
+	if len(interceptor.beforeCalls) != 1 || interceptor.beforeCalls[0] != "PlanResourceChange" {
+		t.Fatalf("expected exactly one BeforeCall for PlanResourceChange, got %v", interceptor.beforeCalls)
+	}
+	if len(interceptor.afterCalls) != 1 || interceptor.afterCalls[0] != "PlanResourceChange" {
+		t.Fatalf("expected exactly one AfterCall for PlanResourceChange, got %v", interceptor.afterCalls)
+	}
+}
+
+func TestGRPCProvider_RecordTo(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	var log bytes.Buffer
+	p.RecordTo(&log)
+
+	client.EXPECT().PlanResourceChange(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.PlanResourceChange_Response{
+		PlannedState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+	}, nil)
+
+	resp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		ProposedNewState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+	})
+	checkDiags(t, resp.Diagnostics)
+
+	lines := strings.Split(strings.TrimSpace(log.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 log entries (request and response), got %d: %s", len(lines), log.String())
+	}
+
+	var req, respEntry recordedCall
+	if err := json.Unmarshal([]byte(lines[0]), &req); err != nil {
+		t.Fatalf("unmarshaling request entry: %s", err)
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &respEntry); err != nil {
+		t.Fatalf("unmarshaling response entry: %s", err)
+	}
+
+	if req.Method != "PlanResourceChange" || req.Phase != "request" {
+		t.Fatalf("unexpected request entry: %+v", req)
+	}
+	if respEntry.Method != "PlanResourceChange" || respEntry.Phase != "response" {
+		t.Fatalf("unexpected response entry: %+v", respEntry)
+	}
+	if !strings.Contains(string(req.Proto), "resource") {
+		t.Fatalf("expected request proto to mention the resource type, got %s", req.Proto)
+	}
+}
+
+func TestGRPCProvider_PlanResourceChangeJSON(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	expectedPrivate := []byte(`{"meta": "data"}`)
+
+	client.EXPECT().PlanResourceChange(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.PlanResourceChange_Response{
+		PlannedState: &proto.DynamicValue{
+			Json: []byte(`{"attr":"bar"}`),
+		},
+		RequiresReplace: []*proto.AttributePath{
+			{
+				Steps: []*proto.AttributePath_Step{
+					{
+						Selector: &proto.AttributePath_Step_AttributeName{
+							AttributeName: "attr",
+						},
+					},
+				},
+			},
+		},
+		PlannedPrivate: expectedPrivate,
+	}, nil)
+
+	resp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		ProposedNewState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	expectedState := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+
+	if !cmp.Equal(expectedState, resp.PlannedState, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expectedState, resp.PlannedState, typeComparer, valueComparer, equateEmpty))
+	}
+
+	expectedReplace := `[]cty.Path{cty.Path{cty.GetAttrStep{Name:"attr"}}}`
+	replace := fmt.Sprintf("%#v", resp.RequiresReplace)
+	if expectedReplace != replace {
+		t.Fatalf("expected %q, got %q", expectedReplace, replace)
+	}
+
+	if !bytes.Equal(expectedPrivate, resp.PlannedPrivate) {
+		t.Fatalf("expected %q, got %q", expectedPrivate, resp.PlannedPrivate)
+	}
+}
+
+func TestGRPCProvider_ApplyResourceChange(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	expectedPrivate := []byte(`{"meta": "data"}`)
+
+	client.EXPECT().ApplyResourceChange(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ApplyResourceChange_Response{
+		NewState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+		Private: expectedPrivate,
+	}, nil)
+
+	resp := p.ApplyResourceChange(providers.ApplyResourceChangeRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		PlannedState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		PlannedPrivate: expectedPrivate,
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	expectedState := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+
+	if !cmp.Equal(expectedState, resp.NewState, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expectedState, resp.NewState, typeComparer, valueComparer, equateEmpty))
+	}
+
+	if !bytes.Equal(expectedPrivate, resp.Private) {
+		t.Fatalf("expected %q, got %q", expectedPrivate, resp.Private)
+	}
+}
+
+func TestGRPCProvider_ApplyResourceChange_maxSendMsgSize(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client:         client,
+		MaxSendMsgSize: 128 << 20,
+	}
+
+	var gotOpts []grpc.CallOption
+	client.EXPECT().ApplyResourceChange(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ *proto.ApplyResourceChange_Request, opts ...grpc.CallOption) (*proto.ApplyResourceChange_Response, error) {
+		gotOpts = opts
+		return &proto.ApplyResourceChange_Response{
+			NewState: &proto.DynamicValue{
+				Msgpack: []byte("\x81\xa4attr\xa3bar"),
+			},
+		}, nil
+	})
+
+	resp := p.ApplyResourceChange(providers.ApplyResourceChangeRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		PlannedState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+	})
+	checkDiags(t, resp.Diagnostics)
+
+	if len(gotOpts) != 1 {
+		t.Fatalf("expected 1 call option, got %d", len(gotOpts))
+	}
+	sizeOpt, ok := gotOpts[0].(grpc.MaxSendMsgSizeCallOption)
+	if !ok {
+		t.Fatalf("expected a MaxSendMsgSizeCallOption, got %#v", gotOpts[0])
+	}
+	if sizeOpt.MaxSendMsgSize != p.MaxSendMsgSize {
+		t.Fatalf("expected MaxSendMsgSize %d, got %d", p.MaxSendMsgSize, sizeOpt.MaxSendMsgSize)
+	}
+}
+
+func TestGRPCProvider_ApplyResourceChangeStream(t *testing.T) {
+	// The plugin protocol has no server-streaming ApplyResourceChange RPC,
+	// so the provider can only ever reply with its one unary response; this
+	// confirms that response still arrives as a single Completed event.
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	expectedPrivate := []byte(`{"meta": "data"}`)
+
+	client.EXPECT().ApplyResourceChange(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ApplyResourceChange_Response{
+		NewState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+		Private: expectedPrivate,
+	}, nil)
+
+	resp := p.ApplyResourceChangeStream(providers.ApplyResourceChangeRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		PlannedState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		PlannedPrivate: expectedPrivate,
+	})
+
+	var events []providers.ApplyResourceChangeEvent
+	for event := range resp.Events.Seq {
+		events = append(events, event)
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("expected exactly one event, got %d", len(events))
+	}
+	completed, ok := events[0].(providers.ApplyResourceChangeEvent_Completed)
+	if !ok {
+		t.Fatalf("expected a Completed event, got %T", events[0])
+	}
+
+	checkDiags(t, completed.Response.Diagnostics)
+
+	if !bytes.Equal(expectedPrivate, completed.Response.Private) {
+		t.Fatalf("expected %q, got %q", expectedPrivate, completed.Response.Private)
+	}
+}
+
+func TestGRPCProvider_SawLegacyTypeSystem(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	if p.SawLegacyTypeSystem() {
+		t.Fatal("expected SawLegacyTypeSystem to start false")
+	}
+
+	client.EXPECT().ApplyResourceChange(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ApplyResourceChange_Response{
+		NewState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+		LegacyTypeSystem: true,
+	}, nil)
+
+	resp := p.ApplyResourceChange(providers.ApplyResourceChangeRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		PlannedState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+	})
+	checkDiags(t, resp.Diagnostics)
+
+	if !p.SawLegacyTypeSystem() {
+		t.Fatal("expected SawLegacyTypeSystem to latch true after a LegacyTypeSystem response")
+	}
+}
+
+func TestGRPCProvider_ApplyResourceChange_idempotencyKey(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	var gotKey string
+	client.EXPECT().ApplyResourceChange(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *proto.ApplyResourceChange_Request, _ ...grpc.CallOption) (*proto.ApplyResourceChange_Response, error) {
+			gotKey = req.IdempotencyKey
+			return &proto.ApplyResourceChange_Response{
+				NewState: &proto.DynamicValue{Msgpack: []byte("\x81\xa4attr\xa3bar")},
+			}, nil
+		})
+
+	resp := p.ApplyResourceChange(providers.ApplyResourceChangeRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		PlannedState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		IdempotencyKey: "apply-12345",
+	})
+	checkDiags(t, resp.Diagnostics)
+
+	if gotKey != "apply-12345" {
+		t.Fatalf("expected IdempotencyKey %q on the wire, got %q", "apply-12345", gotKey)
+	}
+}
+
+func TestGRPCProvider_ApplyResourceChange_notices(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	expectedNotices := []string{"resource will take ~5 min to become active"}
+
+	client.EXPECT().ApplyResourceChange(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ApplyResourceChange_Response{
+		NewState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+		Notices: expectedNotices,
+	}, nil)
+
+	resp := p.ApplyResourceChange(providers.ApplyResourceChangeRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		PlannedState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+	})
+
+	// Notices decode independently of diagnostics: there are none here.
+	checkDiags(t, resp.Diagnostics)
+
+	if !reflect.DeepEqual(expectedNotices, resp.Notices) {
+		t.Fatalf("expected notices %#v, got %#v", expectedNotices, resp.Notices)
+	}
+}
+
+func TestGRPCProvider_ApplyResourceChangeUnknownNewState(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	unknownMP, err := msgpack.Marshal(cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.UnknownVal(cty.String),
+	}), cty.Object(map[string]cty.Type{"attr": cty.String}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.EXPECT().ApplyResourceChange(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ApplyResourceChange_Response{
+		NewState: &proto.DynamicValue{
+			Msgpack: unknownMP,
+		},
+	}, nil)
+
+	resp := p.ApplyResourceChange(providers.ApplyResourceChangeRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		PlannedState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_ApplyResourceChangeJSON(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	expectedPrivate := []byte(`{"meta": "data"}`)
+
+	client.EXPECT().ApplyResourceChange(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ApplyResourceChange_Response{
+		NewState: &proto.DynamicValue{
+			Json: []byte(`{"attr":"bar"}`),
+		},
+		Private: expectedPrivate,
+	}, nil)
+
+	resp := p.ApplyResourceChange(providers.ApplyResourceChangeRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		PlannedState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		PlannedPrivate: expectedPrivate,
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	expectedState := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+
+	if !cmp.Equal(expectedState, resp.NewState, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expectedState, resp.NewState, typeComparer, valueComparer, equateEmpty))
+	}
+
+	if !bytes.Equal(expectedPrivate, resp.Private) {
+		t.Fatalf("expected %q, got %q", expectedPrivate, resp.Private)
+	}
+}
+
+func TestGRPCProvider_ImportResourceState(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	expectedPrivate := []byte(`{"meta": "data"}`)
+
+	client.EXPECT().ImportResourceState(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ImportResourceState_Response{
+		ImportedResources: []*proto.ImportResourceState_ImportedResource{
+			{
+				TypeName: "resource",
+				State: &proto.DynamicValue{
+					Msgpack: []byte("\x81\xa4attr\xa3bar"),
+				},
+				Private: expectedPrivate,
+			},
+		},
+	}, nil)
+
+	resp := p.ImportResourceState(providers.ImportResourceStateRequest{
+		TypeName: "resource",
+		ID:       "foo",
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	expectedResource := providers.ImportedResource{
+		TypeName: "resource",
+		State: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Private: expectedPrivate,
+	}
+
+	imported := resp.ImportedResources[0]
+	if !cmp.Equal(expectedResource, imported, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expectedResource, imported, typeComparer, valueComparer, equateEmpty))
+	}
+}
+
+func TestGRPCProvider_ImportResourceState_writeOnlyValue(t *testing.T) {
+	client := mockproto.NewMockProviderClient(gomock.NewController(t))
+	client.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(&proto.GetProviderSchema_Response{
+		Provider: &proto.Schema{Block: &proto.Schema_Block{}},
+		ResourceSchemas: map[string]*proto.Schema{
+			"resource": {
+				Block: &proto.Schema_Block{
+					Attributes: []*proto.Schema_Attribute{
+						{Name: "attr", Type: []byte(`"string"`), Optional: true},
+						{Name: "secret", Type: []byte(`"string"`), Optional: true, WriteOnly: true},
+					},
+				},
+			},
+		},
+	}, nil)
+	client.EXPECT().GetResourceIdentitySchemas(gomock.Any(), gomock.Any(), gomock.Any()).Return(&proto.GetResourceIdentitySchemas_Response{}, nil)
+
+	p := &GRPCProvider{client: client}
+
+	client.EXPECT().ImportResourceState(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ImportResourceState_Response{
+		ImportedResources: []*proto.ImportResourceState_ImportedResource{
+			{
+				TypeName: "resource",
+				State: &proto.DynamicValue{
+					Msgpack: []byte("\x82\xa4attr\xa3bar\xa6secret\xa4leak"),
+				},
+			},
+		},
+	}, nil)
+
+	resp := p.ImportResourceState(providers.ImportResourceStateRequest{
+		TypeName: "resource",
+		ID:       "foo",
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+	got := resp.Diagnostics.Err().Error()
+	if !strings.Contains(got, "resource") || !strings.Contains(got, "secret") {
+		t.Fatalf("expected diagnostic to mention the resource type and the write-only attribute, got: %s", got)
+	}
+}
+
+func TestGRPCProvider_ImportResourceStates(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().ImportResourceState(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ImportResourceState_Response{
+		ImportedResources: []*proto.ImportResourceState_ImportedResource{
+			{
+				TypeName: "resource",
+				State: &proto.DynamicValue{
+					Msgpack: []byte("\x81\xa4attr\xa3bar"),
+				},
+			},
+		},
+	}, nil)
+
+	client.EXPECT().ImportResourceState(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ImportResourceState_Response{
+		ImportedResources: []*proto.ImportResourceState_ImportedResource{
+			{
+				TypeName: "resource",
+				State: &proto.DynamicValue{
+					Msgpack: []byte("\x81\xa4attr\xa3baz"),
+				},
+			},
+		},
+	}, nil)
+
+	resps := p.ImportResourceStates([]providers.ImportResourceStateRequest{
+		{TypeName: "resource", ID: "foo"},
+		{TypeName: "resource", ID: "bar"},
+	})
+
+	if len(resps) != 2 {
+		t.Fatalf("expected 2 responses, got %d", len(resps))
+	}
+	checkDiags(t, resps[0].Diagnostics)
+	checkDiags(t, resps[1].Diagnostics)
+
+	if got := resps[0].ImportedResources[0].State.GetAttr("attr"); got.AsString() != "bar" {
+		t.Fatalf("expected the first response to correspond to the first request, got %q", got.AsString())
+	}
+	if got := resps[1].ImportedResources[0].State.GetAttr("attr"); got.AsString() != "baz" {
+		t.Fatalf("expected the second response to correspond to the second request, got %q", got.AsString())
+	}
+}
+
+func TestGRPCProvider_ImportResourceStateJSON(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	expectedPrivate := []byte(`{"meta": "data"}`)
+
+	client.EXPECT().ImportResourceState(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ImportResourceState_Response{
+		ImportedResources: []*proto.ImportResourceState_ImportedResource{
+			{
+				TypeName: "resource",
+				State: &proto.DynamicValue{
+					Json: []byte(`{"attr":"bar"}`),
+				},
+				Private: expectedPrivate,
+			},
+		},
+	}, nil)
+
+	resp := p.ImportResourceState(providers.ImportResourceStateRequest{
+		TypeName: "resource",
+		ID:       "foo",
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	expectedResource := providers.ImportedResource{
+		TypeName: "resource",
+		State: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Private: expectedPrivate,
+	}
+
+	imported := resp.ImportedResources[0]
+	if !cmp.Equal(expectedResource, imported, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expectedResource, imported, typeComparer, valueComparer, equateEmpty))
+	}
+}
+
+func TestGRPCProvider_ImportResourceState_Identity(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().ImportResourceState(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ImportResourceState_Response{
+		ImportedResources: []*proto.ImportResourceState_ImportedResource{
+			{
+				TypeName: "resource",
+				State: &proto.DynamicValue{
+					Msgpack: []byte("\x81\xa4attr\xa3bar"),
+				},
+				Identity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Msgpack: []byte("\x81\xa7id_attr\xa3foo"),
+					},
+				},
+			},
+		},
+	}, nil)
+
+	resp := p.ImportResourceState(providers.ImportResourceStateRequest{
+		TypeName: "resource",
+		Identity: cty.ObjectVal(map[string]cty.Value{
+			"id_attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	expectedResource := providers.ImportedResource{
+		TypeName: "resource",
+		State: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Identity: cty.ObjectVal(map[string]cty.Value{
+			"id_attr": cty.StringVal("foo"),
+		}),
+	}
+
+	imported := resp.ImportedResources[0]
+	if !cmp.Equal(expectedResource, imported, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expectedResource, imported, typeComparer, valueComparer, equateEmpty))
+	}
+}
+
+func TestGRPCProvider_ImportResourceState_identityWithoutSchema(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// "no_identity_resource" has no identity schema, but the provider
+	// returns identity data for it anyway.
+	client.EXPECT().ImportResourceState(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ImportResourceState_Response{
+		ImportedResources: []*proto.ImportResourceState_ImportedResource{
+			{
+				TypeName: "no_identity_resource",
+				State: &proto.DynamicValue{
+					Msgpack: []byte("\x81\xa4attr\xa3bar"),
+				},
+				Identity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Msgpack: []byte("\x81\xa7id_attr\xa2id"),
+					},
+				},
+			},
+		},
+	}, nil)
+
+	resp := p.ImportResourceState(providers.ImportResourceStateRequest{
+		TypeName: "no_identity_resource",
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+	if !strings.Contains(resp.Diagnostics.Err().Error(), "unknown identity type") {
+		t.Fatalf("expected diagnostics to mention unknown identity type, got %s", resp.Diagnostics.Err())
+	}
+}
+
+func TestGRPCProvider_MoveResourceState(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	expectedTargetPrivate := []byte(`{"target": "private"}`)
+	expectedTargetState := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+
+	client.EXPECT().MoveResourceState(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.MoveResourceState_Response{
+		TargetState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+		TargetPrivate: expectedTargetPrivate,
+	}, nil)
+
+	resp := p.MoveResourceState(providers.MoveResourceStateRequest{
+		SourcePrivate:   []byte(`{"source": "private"}`),
+		SourceStateJSON: []byte(`{"source_attr":"bar"}`),
+		TargetTypeName:  "resource",
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	if !cmp.Equal(expectedTargetPrivate, resp.TargetPrivate, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expectedTargetPrivate, resp.TargetPrivate, typeComparer, valueComparer, equateEmpty))
+	}
+
+	if !cmp.Equal(expectedTargetState, resp.TargetState, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expectedTargetState, resp.TargetState, typeComparer, valueComparer, equateEmpty))
+	}
+}
+
+func TestGRPCProvider_MoveResourceState_validate(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	expectedTargetState := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+
+	client.EXPECT().MoveResourceState(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.MoveResourceState_Response{
+		TargetState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+	}, nil)
+
+	resp := p.MoveResourceState(providers.MoveResourceStateRequest{
+		SourceStateJSON: []byte(`{"source_attr":"bar"}`),
+		TargetTypeName:  "resource",
+		Validate:        true,
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	if !cmp.Equal(expectedTargetState, resp.TargetState, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expectedTargetState, resp.TargetState, typeComparer, valueComparer, equateEmpty))
+	}
+}
+
+func TestGRPCProvider_MoveResourceState_deferred(t *testing.T) {
+	// Unlike PlanResourceChange and ReadDataSource, MoveResourceState.Response
+	// has no deferred field on the wire, so a provider has no way to signal a
+	// deferred move; Deferred is always nil until tfplugin5.proto/
+	// tfplugin6.proto grow one.
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().MoveResourceState(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.MoveResourceState_Response{
+		TargetState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+	}, nil)
+
+	resp := p.MoveResourceState(providers.MoveResourceStateRequest{
+		SourceStateJSON: []byte(`{"source_attr":"bar"}`),
+		TargetTypeName:  "resource",
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	if resp.Deferred != nil {
+		t.Fatalf("expected Deferred to be nil, got %#v", resp.Deferred)
+	}
+}
+
+func TestGRPCProvider_MoveResourceStateJSON(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	expectedTargetPrivate := []byte(`{"target": "private"}`)
+	expectedTargetState := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+
+	client.EXPECT().MoveResourceState(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.MoveResourceState_Response{
+		TargetState: &proto.DynamicValue{
+			Json: []byte(`{"attr":"bar"}`),
+		},
+		TargetPrivate: expectedTargetPrivate,
+	}, nil)
+
+	resp := p.MoveResourceState(providers.MoveResourceStateRequest{
+		SourcePrivate:   []byte(`{"source": "private"}`),
+		SourceStateJSON: []byte(`{"source_attr":"bar"}`),
+		TargetTypeName:  "resource",
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	if !cmp.Equal(expectedTargetPrivate, resp.TargetPrivate, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expectedTargetPrivate, resp.TargetPrivate, typeComparer, valueComparer, equateEmpty))
+	}
+
+	if !cmp.Equal(expectedTargetState, resp.TargetState, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expectedTargetState, resp.TargetState, typeComparer, valueComparer, equateEmpty))
+	}
+}
+
+func TestGRPCProvider_ReadDataSource(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().ReadDataSource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ReadDataSource_Response{
+		State: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+	}, nil)
+
+	resp := p.ReadDataSource(providers.ReadDataSourceRequest{
+		TypeName: "data",
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	expected := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+
+	if !cmp.Equal(expected, resp.State, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expected, resp.State, typeComparer, valueComparer, equateEmpty))
+	}
+}
+
+func TestGRPCProvider_ReadDataSource_unknownType(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	resp := p.ReadDataSource(providers.ReadDataSourceRequest{
+		TypeName: "nonexistent",
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_ReadDataSourceJSON(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().ReadDataSource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ReadDataSource_Response{
+		State: &proto.DynamicValue{
+			Json: []byte(`{"attr":"bar"}`),
+		},
+	}, nil)
+
+	resp := p.ReadDataSource(providers.ReadDataSourceRequest{
+		TypeName: "data",
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	expected := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+
+	if !cmp.Equal(expected, resp.State, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expected, resp.State, typeComparer, valueComparer, equateEmpty))
+	}
+}
+
+func TestGRPCProvider_ReadDataSource_retryUntil(t *testing.T) {
+	oldInterval := readDataSourceRetryInterval
+	readDataSourceRetryInterval = time.Millisecond
+	defer func() { readDataSourceRetryInterval = oldInterval }()
+
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// The fake data source returns an empty attr twice before it stabilizes.
+	empty := &proto.ReadDataSource_Response{
+		State: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa0"),
+		},
+	}
+	ready := &proto.ReadDataSource_Response{
+		State: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+	}
+	gomock.InOrder(
+		client.EXPECT().ReadDataSource(gomock.Any(), gomock.Any()).Return(empty, nil),
+		client.EXPECT().ReadDataSource(gomock.Any(), gomock.Any()).Return(empty, nil),
+		client.EXPECT().ReadDataSource(gomock.Any(), gomock.Any()).Return(ready, nil),
+	)
+
+	resp := p.ReadDataSource(providers.ReadDataSourceRequest{
+		TypeName: "data",
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		RetryUntil: func(v cty.Value) bool {
+			return v.GetAttr("attr").AsString() != ""
+		},
+	})
+	checkDiags(t, resp.Diagnostics)
+
+	expected := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+	if !cmp.Equal(expected, resp.State, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expected, resp.State, typeComparer, valueComparer, equateEmpty))
+	}
+}
+
+func TestGRPCProvider_ReadDataSource_retryUntil_exhausted(t *testing.T) {
+	oldInterval := readDataSourceRetryInterval
+	readDataSourceRetryInterval = time.Millisecond
+	defer func() { readDataSourceRetryInterval = oldInterval }()
+
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	empty := &proto.ReadDataSource_Response{
+		State: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa0"),
+		},
+	}
+	client.EXPECT().ReadDataSource(gomock.Any(), gomock.Any()).Return(empty, nil).Times(readDataSourceRetryMaxAttempts)
+
+	resp := p.ReadDataSource(providers.ReadDataSourceRequest{
+		TypeName: "data",
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		RetryUntil: func(v cty.Value) bool {
+			return v.GetAttr("attr").AsString() != ""
+		},
+	})
+
+	// Exhausting the attempts is a warning, not an error: the last result is
+	// still returned for the caller to use.
+	if resp.Diagnostics.HasErrors() {
+		t.Fatalf("expected no errors, got: %s", resp.Diagnostics.Err())
+	}
+	if !resp.Diagnostics.HasWarnings() {
+		t.Fatal("expected a warning that RetryUntil was never satisfied")
+	}
+
+	expected := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal(""),
+	})
+	if !cmp.Equal(expected, resp.State, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expected, resp.State, typeComparer, valueComparer, equateEmpty))
+	}
+}
+
+func TestGRPCProvider_CallFunctionCache(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client:        client,
+		FunctionCache: NewFunctionCache(),
+	}
+
+	client.EXPECT().CallFunction(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.CallFunction_Response{
+		Result: &proto.DynamicValue{
+			Msgpack: []byte("\xa3bar"),
+		},
+	}, nil).Times(1)
+
+	req := providers.CallFunctionRequest{
+		FunctionName: "noop",
+		Arguments:    []cty.Value{cty.StringVal("foo")},
+	}
+
+	first := p.CallFunction(req)
+	if first.Err != nil {
+		t.Fatal(first.Err)
+	}
+
+	second := p.CallFunction(req)
+	if second.Err != nil {
+		t.Fatal(second.Err)
+	}
+
+	if !cmp.Equal(cty.StringVal("bar"), second.Result, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(cty.StringVal("bar"), second.Result, typeComparer, valueComparer, equateEmpty))
+	}
+
+	p.Close()
+	if len(p.FunctionCache.entries) != 0 {
+		t.Fatal("expected FunctionCache to be cleared on Close")
+	}
+}
+
+func TestGRPCProvider_CallFunctions(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	inputs := []string{"one", "two", "three", "four", "five"}
+
+	client.EXPECT().CallFunction(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *proto.CallFunction_Request, _ ...grpc.CallOption) (*proto.CallFunction_Response, error) {
+		// Echo the argument straight back, so the response can be matched
+		// up against the request that produced it.
+		return &proto.CallFunction_Response{Result: req.Arguments[0]}, nil
+	}).Times(len(inputs))
+
+	reqs := make([]providers.CallFunctionRequest, len(inputs))
+	for i, input := range inputs {
+		reqs[i] = providers.CallFunctionRequest{
+			FunctionName: "noop",
+			Arguments:    []cty.Value{cty.StringVal(input)},
+		}
+	}
+
+	resps := p.CallFunctions(reqs, 3)
+	if len(resps) != len(inputs) {
+		t.Fatalf("expected %d responses, got %d", len(inputs), len(resps))
+	}
+	for i, input := range inputs {
+		if resps[i].Err != nil {
+			t.Fatalf("response %d: %s", i, resps[i].Err)
+		}
+		if got := resps[i].Result.AsString(); got != input {
+			t.Errorf("response %d: expected %q, got %q", i, input, got)
+		}
+	}
+}
+
+func TestGRPCProvider_RecommendedTimeouts(t *testing.T) {
+	p := &GRPCProvider{}
+
+	got := p.RecommendedTimeouts()
+	if got == nil {
+		t.Fatal("expected a non-nil map")
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected an empty map, got %#v", got)
+	}
+}
+
+func TestGRPCProvider_CallFunction_rejectsNullArg(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// The "input" parameter of "noop" doesn't set AllowNullValue, so a null
+	// argument must be rejected before ever reaching the provider.
+	resp := p.CallFunction(providers.CallFunctionRequest{
+		FunctionName: "noop",
+		Arguments:    []cty.Value{cty.NullVal(cty.String)},
+	})
+
+	if resp.Err == nil {
+		t.Fatal("expected an error")
+	}
+	argErr, ok := resp.Err.(function.ArgError)
+	if !ok {
+		t.Fatalf("expected a function.ArgError, got: %#v", resp.Err)
+	}
+	if argErr.Index != 0 {
+		t.Fatalf("expected ArgError for argument 0, got %d", argErr.Index)
+	}
+}
+
+func TestGRPCProvider_CallFunction_rejectsUnknownArg(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// The "input" parameter of "noop" doesn't set AllowUnknownValues, so an
+	// unknown argument must be rejected before ever reaching the provider.
+	resp := p.CallFunction(providers.CallFunctionRequest{
+		FunctionName: "noop",
+		Arguments:    []cty.Value{cty.UnknownVal(cty.String)},
+	})
+
+	if resp.Err == nil {
+		t.Fatal("expected an error")
+	}
+	argErr, ok := resp.Err.(function.ArgError)
+	if !ok {
+		t.Fatalf("expected a function.ArgError, got: %#v", resp.Err)
+	}
+	if argErr.Index != 0 {
+		t.Fatalf("expected ArgError for argument 0, got %d", argErr.Index)
+	}
+}
+
+func TestGRPCProvider_ReadDataSourceCache(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client:          client,
+		DataSourceCache: NewDataSourceCache(),
+	}
+
+	client.EXPECT().ReadDataSource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ReadDataSource_Response{
+		State: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+	}, nil).Times(1)
+
+	req := providers.ReadDataSourceRequest{
+		TypeName: "data",
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	}
+
+	first := p.ReadDataSource(req)
+	checkDiags(t, first.Diagnostics)
+
+	second := p.ReadDataSource(req)
+	checkDiags(t, second.Diagnostics)
+
+	expected := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+
+	if !cmp.Equal(expected, second.State, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expected, second.State, typeComparer, valueComparer, equateEmpty))
+	}
+
+	p.Close()
+	if len(p.DataSourceCache.entries) != 0 {
+		t.Fatal("expected DataSourceCache to be cleared on Close")
+	}
+}
+
+func TestGRPCProvider_ValidateProviderConfigCache(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client:                      client,
+		ValidateProviderConfigCache: NewValidateProviderConfigCache(),
+	}
+
+	client.EXPECT().PrepareProviderConfig(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.PrepareProviderConfig_Response{
+		PreparedConfig: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+	}, nil).Times(1)
+
+	req := providers.ValidateProviderConfigRequest{
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+	}
+
+	first := p.ValidateProviderConfig(req)
+	checkDiags(t, first.Diagnostics)
+
+	second := p.ValidateProviderConfig(req)
+	checkDiags(t, second.Diagnostics)
+
+	p.ClearValidateCache()
+	if len(p.ValidateProviderConfigCache.entries) != 0 {
+		t.Fatal("expected ValidateProviderConfigCache to be cleared")
+	}
+}
+
+func TestGRPCProvider_openEphemeralResource(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().OpenEphemeralResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.OpenEphemeralResource_Response{
+		Result: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+		RenewAt: timestamppb.New(time.Now().Add(time.Second)),
+		Private: []byte("private data"),
+	}, nil)
+
+	resp := p.OpenEphemeralResource(providers.OpenEphemeralResourceRequest{
+		TypeName: "ephemeral",
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.NullVal(cty.String),
+		}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	expected := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+
+	if !cmp.Equal(expected, resp.Result, typeComparer, valueComparer, equateEmpty) {
+		t.Fatal(cmp.Diff(expected, resp.Result, typeComparer, valueComparer, equateEmpty))
+	}
+
+	if !resp.RenewAt.After(time.Now()) {
+		t.Fatal("invalid RenewAt:", resp.RenewAt)
+	}
+
+	if !bytes.Equal(resp.Private, []byte("private data")) {
+		t.Fatalf("invalid private data: %q", resp.Private)
+	}
+}
+
+func TestGRPCProvider_openEphemeralResourceRefinements(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	refined := cty.UnknownVal(cty.String).Refine().
+		NotNull().
+		StringPrefix("x").
+		NewValue()
+
+	mp, err := msgpack.Marshal(
+		cty.ObjectVal(map[string]cty.Value{"attr": refined}),
+		cty.Object(map[string]cty.Type{"attr": cty.String}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.EXPECT().OpenEphemeralResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.OpenEphemeralResource_Response{
+		Result: &proto.DynamicValue{
+			Msgpack: mp,
+		},
+	}, nil)
+
+	resp := p.OpenEphemeralResource(providers.OpenEphemeralResourceRequest{
+		TypeName: "ephemeral",
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.NullVal(cty.String),
+		}),
+	})
+	checkDiags(t, resp.Diagnostics)
+
+	got := resp.Result.GetAttr("attr")
+	if got.IsKnown() {
+		t.Fatal("expected attr to still be unknown")
+	}
+	if got.Range().DefinitelyNotNull() != true {
+		t.Fatal("expected the NotNull refinement to survive the round trip")
+	}
+}
+
+func TestGRPCProvider_renewEphemeralResource(t *testing.T) {
+	client := mockproto.NewMockProviderClient(gomock.NewController(t))
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().RenewEphemeralResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.RenewEphemeralResource_Response{
+		RenewAt: timestamppb.New(time.Now().Add(time.Second)),
+		Private: []byte("private data"),
+	}, nil)
+
+	resp := p.RenewEphemeralResource(providers.RenewEphemeralResourceRequest{
+		TypeName: "ephemeral",
+		Private:  []byte("private data"),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+
+	if !resp.RenewAt.After(time.Now()) {
+		t.Fatal("invalid RenewAt:", resp.RenewAt)
+	}
+
+	if !bytes.Equal(resp.Private, []byte("private data")) {
+		t.Fatalf("invalid private data: %q", resp.Private)
+	}
+}
+
+func TestGRPCProvider_renewEphemeralResource_elapsedRenewAt(t *testing.T) {
+	client := mockproto.NewMockProviderClient(gomock.NewController(t))
+
+	fakeNow := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := &GRPCProvider{
+		client: client,
+		Now:    func() time.Time { return fakeNow },
+	}
+
+	client.EXPECT().RenewEphemeralResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.RenewEphemeralResource_Response{
+		RenewAt: timestamppb.New(fakeNow.Add(-time.Second)),
+	}, nil)
+
+	resp := p.RenewEphemeralResource(providers.RenewEphemeralResourceRequest{
+		TypeName: "ephemeral",
+	})
+
+	if !resp.Diagnostics.HasWarnings() {
+		t.Fatal("expected a warning diagnostic for an already-elapsed RenewAt")
+	}
+
+	if want := fakeNow.Add(minEphemeralRenewalDelay); !resp.RenewAt.Equal(want) {
+		t.Fatalf("expected RenewAt to be clamped to %s, got %s", want, resp.RenewAt)
+	}
+}
+
+func TestGRPCProvider_openEphemeralResource_elapsedRenewAt(t *testing.T) {
+	client := mockProviderClient(t)
+
+	fakeNow := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := &GRPCProvider{
+		client: client,
+		Now:    func() time.Time { return fakeNow },
+	}
+
+	client.EXPECT().OpenEphemeralResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.OpenEphemeralResource_Response{
+		Result: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+		RenewAt: timestamppb.New(fakeNow.Add(-time.Second)),
+	}, nil)
+
+	resp := p.OpenEphemeralResource(providers.OpenEphemeralResourceRequest{
+		TypeName: "ephemeral",
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.NullVal(cty.String),
+		}),
+	})
+
+	if !resp.Diagnostics.HasWarnings() {
+		t.Fatal("expected a warning diagnostic for an already-elapsed RenewAt")
+	}
+
+	if want := fakeNow.Add(minEphemeralRenewalDelay); !resp.RenewAt.Equal(want) {
+		t.Fatalf("expected RenewAt to be clamped to %s, got %s", want, resp.RenewAt)
+	}
+}
+
+func TestGRPCProvider_closeEphemeralResource(t *testing.T) {
+	client := mockproto.NewMockProviderClient(gomock.NewController(t))
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().CloseEphemeralResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.CloseEphemeralResource_Response{}, nil)
+
+	resp := p.CloseEphemeralResource(providers.CloseEphemeralResourceRequest{
+		TypeName: "ephemeral",
+		Private:  []byte("private data"),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_closeEphemeralResources(t *testing.T) {
+	client := mockproto.NewMockProviderClient(gomock.NewController(t))
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	var closed []string
+	client.EXPECT().CloseEphemeralResource(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(
+		func(_ context.Context, req *proto.CloseEphemeralResource_Request, _ ...grpc.CallOption) (*proto.CloseEphemeralResource_Response, error) {
+			closed = append(closed, req.TypeName)
+			if req.TypeName == "ephemeral_bad" {
+				return &proto.CloseEphemeralResource_Response{
+					Diagnostics: []*proto.Diagnostic{
+						{
+							Severity: proto.Diagnostic_ERROR,
+							Summary:  "failed to close",
+						},
+					},
+				}, nil
+			}
+			return &proto.CloseEphemeralResource_Response{}, nil
+		},
+	).Times(3)
+
+	diags := p.CloseEphemeralResources([]providers.CloseEphemeralResourceRequest{
+		{TypeName: "ephemeral_one"},
+		{TypeName: "ephemeral_bad"},
+		{TypeName: "ephemeral_two"},
+	})
+
+	checkDiagsHasError(t, diags)
+
+	if len(closed) != 3 {
+		t.Fatalf("expected all 3 ephemeral resources to be closed, got %d: %v", len(closed), closed)
+	}
+}
+
+func TestGRPCProvider_CloseGracefully(t *testing.T) {
+	client := mockproto.NewMockProviderClient(gomock.NewController(t))
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	var closed []string
+	client.EXPECT().CloseEphemeralResource(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(
+		func(_ context.Context, req *proto.CloseEphemeralResource_Request, _ ...grpc.CallOption) (*proto.CloseEphemeralResource_Response, error) {
+			closed = append(closed, req.TypeName)
+			return &proto.CloseEphemeralResource_Response{}, nil
+		},
+	).Times(2)
+
+	err := p.CloseGracefully(context.Background(), []providers.CloseEphemeralResourceRequest{
+		{TypeName: "ephemeral_one"},
+		{TypeName: "ephemeral_two"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(closed) != 2 {
+		t.Fatalf("expected both ephemeral resources to be closed before Close returned, got %d: %v", len(closed), closed)
+	}
+}
+
+func TestGRPCProvider_CloseGracefully_ctxDone(t *testing.T) {
+	client := mockproto.NewMockProviderClient(gomock.NewController(t))
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	release := make(chan struct{})
+	called := make(chan struct{})
+	client.EXPECT().CloseEphemeralResource(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(
+		func(_ context.Context, req *proto.CloseEphemeralResource_Request, _ ...grpc.CallOption) (*proto.CloseEphemeralResource_Response, error) {
+			<-release
+			close(called)
+			return &proto.CloseEphemeralResource_Response{}, nil
+		},
+	).Times(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := p.CloseGracefully(ctx, []providers.CloseEphemeralResourceRequest{
+		{TypeName: "ephemeral_one"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// CloseGracefully returns as soon as ctx is done, leaving the background
+	// call to CloseEphemeralResource still in flight; wait for it to
+	// actually complete before the test (and its mock controller) exits, or
+	// the Times(1) expectation races with this goroutine.
+	close(release)
+	<-called
+}
+
+func TestGRPCProvider_GetSchema_ListResourceTypes(t *testing.T) {
+	p := &GRPCProvider{
+		client: mockProviderClient(t),
+		ctx:    context.Background(),
+	}
+
+	resp := p.GetProviderSchema()
+	listResourceSchema := resp.ListResourceTypes
+	expected := map[string]providers.Schema{
+		"list": {
+			Version: 1,
+			Body: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"data": {
+						Type:     cty.DynamicPseudoType,
+						Computed: true,
+					},
+				},
+				BlockTypes: map[string]*configschema.NestedBlock{
+					"config": {
+						Block: configschema.Block{
+							Attributes: map[string]*configschema.Attribute{
+								"filter_attr": {
+									Type:     cty.String,
+									Required: true,
+								},
+							},
+						},
+						Nesting: configschema.NestingSingle,
+					},
+				},
+			},
+		},
+	}
+	checkDiags(t, resp.Diagnostics)
+
+	actualBody := convert.ConfigSchemaToProto(listResourceSchema["list"].Body).String()
+	expectedBody := convert.ConfigSchemaToProto(expected["list"].Body).String()
+	if actualBody != expectedBody {
+		t.Fatalf("expected %v, got %v", expectedBody, actualBody)
+	}
+}
+
+func TestGRPCProvider_GetSchema_DuplicateTypeName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
+
+	attr := []*proto.Schema_Attribute{
+		{Name: "attr", Type: []byte(`"string"`), Required: true},
+	}
+	client.EXPECT().GetSchema(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.GetProviderSchema_Response{
+		Provider: &proto.Schema{Block: &proto.Schema_Block{Attributes: attr}},
+		ResourceSchemas: map[string]*proto.Schema{
+			"conflicting": {Block: &proto.Schema_Block{Attributes: attr}},
+		},
+		DataSourceSchemas: map[string]*proto.Schema{
+			"conflicting": {Block: &proto.Schema_Block{Attributes: attr}},
+		},
+	}, nil)
+	client.EXPECT().GetResourceIdentitySchemas(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.GetResourceIdentitySchemas_Response{}, nil)
+
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	resp := p.GetProviderSchema()
+
+	if !resp.Diagnostics.HasWarnings() {
+		t.Fatal("expected a warning diagnostic for the conflicting type name")
+	}
+	if got := resp.Diagnostics.ErrWithWarnings().Error(); !strings.Contains(got, `"conflicting"`) {
+		t.Fatalf("expected warning to mention the conflicting name, got: %s", got)
+	}
+}
+
+func TestGRPCProvider_Encode(t *testing.T) {
 	// TODO: This is the only test in this package that imports plans. If that
 	// ever leads to a circular import, we should consider moving this test to
 	// a different package or refactoring the test to not use plans.
 	p := &GRPCProvider{
-		client: mockProviderClient(t),
-		ctx:    context.Background(),
-		Addr:   addrs.ImpliedProviderForUnqualifiedType("testencode"),
+		client: mockProviderClient(t),
+		ctx:    context.Background(),
+		Addr:   addrs.ImpliedProviderForUnqualifiedType("testencode"),
+	}
+	resp := p.GetProviderSchema()
+
+	src := plans.NewChanges()
+	src.SyncWrapper().AppendResourceInstanceChange(&plans.ResourceInstanceChange{
+		Addr: addrs.AbsResourceInstance{
+			Module: addrs.RootModuleInstance,
+			Resource: addrs.ResourceInstance{
+				Resource: addrs.Resource{
+					Mode: addrs.ListResourceMode,
+					Type: "list",
+					Name: "test",
+				},
+				Key: addrs.NoKey,
+			},
+		},
+		ProviderAddr: addrs.AbsProviderConfig{
+			Provider: p.Addr,
+		},
+		Change: plans.Change{
+			Before: cty.NullVal(cty.Object(map[string]cty.Type{
+				"config": cty.Object(map[string]cty.Type{
+					"filter_attr": cty.String,
+				}),
+				"data": cty.List(cty.Object(map[string]cty.Type{
+					"state": cty.Object(map[string]cty.Type{
+						"resource_attr": cty.String,
+					}),
+					"identity": cty.Object(map[string]cty.Type{
+						"id_attr": cty.String,
+					}),
+				})),
+			})),
+			After: cty.ObjectVal(map[string]cty.Value{
+				"config": cty.ObjectVal(map[string]cty.Value{
+					"filter_attr": cty.StringVal("value"),
+				}),
+				"data": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"state": cty.ObjectVal(map[string]cty.Value{
+							"resource_attr": cty.StringVal("value"),
+						}),
+						"identity": cty.ObjectVal(map[string]cty.Value{
+							"id_attr": cty.StringVal("value"),
+						}),
+					}),
+				}),
+			}),
+		},
+	})
+	_, err := src.Encode(&schemarepo.Schemas{
+		Providers: map[addrs.Provider]providers.ProviderSchema{
+			p.Addr: {
+				ResourceTypes:     resp.ResourceTypes,
+				ListResourceTypes: resp.ListResourceTypes,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error encoding changes: %s", err)
+	}
+}
+
+func TestLinkedResourcePlanDataToProto_zeroLinkedResources(t *testing.T) {
+	schema := providers.GetProviderSchemaResponse{}
+
+	t.Run("both nil", func(t *testing.T) {
+		got, err := linkedResourcePlanDataToProto(schema, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 0 {
+			t.Fatalf("expected an empty result, got %#v", got)
+		}
+	})
+
+	t.Run("schema nil, data empty", func(t *testing.T) {
+		got, err := linkedResourcePlanDataToProto(schema, nil, []providers.LinkedResourcePlanData{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 0 {
+			t.Fatalf("expected an empty result, got %#v", got)
+		}
+	})
+
+	t.Run("schema empty, data nil", func(t *testing.T) {
+		got, err := linkedResourcePlanDataToProto(schema, []providers.LinkedResourceSchema{}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 0 {
+			t.Fatalf("expected an empty result, got %#v", got)
+		}
+	})
+}
+
+func TestLinkedResourceInvokeDataToProto_zeroLinkedResources(t *testing.T) {
+	schema := providers.GetProviderSchemaResponse{}
+
+	t.Run("both nil", func(t *testing.T) {
+		got, err := linkedResourceInvokeDataToProto(schema, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 0 {
+			t.Fatalf("expected an empty result, got %#v", got)
+		}
+	})
+
+	t.Run("schema nil, data empty", func(t *testing.T) {
+		got, err := linkedResourceInvokeDataToProto(schema, nil, []providers.LinkedResourceInvokeData{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 0 {
+			t.Fatalf("expected an empty result, got %#v", got)
+		}
+	})
+}
+
+func TestGRPCProvider_planAction_unlinked_valid(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().PlanAction(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.PlanAction_Response{}, nil)
+
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "unlinked",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_planAction_unlinked_valid_but_fails(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().PlanAction(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.PlanAction_Response{
+		Diagnostics: []*proto.Diagnostic{
+			{
+				Severity: proto.Diagnostic_ERROR,
+				Summary:  "Boom",
+				Detail:   "Explosion",
+			},
+		},
+	}, nil)
+
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "unlinked",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_planAction_unlinked_invalid_config(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "unlinked",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"not_the_right_attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_planAction_unlinked_invalid_config_names_action_and_path(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "unlinked",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.NumberIntVal(1),
+		}),
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+	err := resp.Diagnostics.Err().Error()
+	if !strings.Contains(err, `"unlinked"`) {
+		t.Errorf("expected diagnostic to name the action type, got: %s", err)
+	}
+	if !strings.Contains(err, ".attr") {
+		t.Errorf("expected diagnostic to name the non-conforming attribute, got: %s", err)
+	}
+}
+
+func TestGRPCProvider_planAction_unlinked_extra_linked_resources(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "unlinked",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		LinkedResources: []providers.LinkedResourcePlanData{{
+			PriorState:    cty.NullVal(cty.DynamicPseudoType),
+			PlannedState:  cty.NullVal(cty.DynamicPseudoType),
+			Config:        cty.NullVal(cty.DynamicPseudoType),
+			PriorIdentity: cty.NullVal(cty.DynamicPseudoType),
+		}},
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_planAction_unlinked_invalid_extra_returned_linked_resources(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	plannedState := cty.ObjectVal(map[string]cty.Value{
+		"foo": cty.StringVal("bar"),
+	})
+	plannedStateMp, _ := msgpack.Marshal(plannedState, plannedState.Type())
+
+	client.EXPECT().PlanAction(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.PlanAction_Response{
+		LinkedResources: []*proto.PlanAction_Response_LinkedResource{
+			{
+				PlannedState: &proto.DynamicValue{
+					Msgpack: plannedStateMp,
+				},
+			},
+		},
+	}, nil)
+
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "unlinked",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_planAction_lifecycle_valid(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().PlanAction(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.PlanAction_Response{
+		LinkedResources: []*proto.PlanAction_Response_LinkedResource{{
+			PlannedState: &proto.DynamicValue{
+				Msgpack: []byte("\x81\xa4attr\xa3new"),
+			},
+			PlannedIdentity: &proto.ResourceIdentityData{
+				IdentityData: &proto.DynamicValue{
+					Msgpack: []byte("\x81\xa7id_attr\xa2id"),
+				},
+			},
+		}},
+	}, nil)
+
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "lifecycle",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		LinkedResources: []providers.LinkedResourcePlanData{
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+			},
+		},
+	})
+
+	checkDiags(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_planAction_lifecycle_missing_linked_resource(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// Missing LinkedResources for a lifecycle action should error
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "lifecycle",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_planAction_lifecycle_extra_linked_resource(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// Too many LinkedResources for a lifecycle action should error
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "lifecycle",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		LinkedResources: []providers.LinkedResourcePlanData{
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+			},
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+			},
+		},
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_planAction_lifecycle_invalid_config(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// Invalid config for the action schema
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "lifecycle",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"not_the_right_attr": cty.StringVal("foo"),
+		}),
+		LinkedResources: []providers.LinkedResourcePlanData{
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+			},
+		},
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_planAction_lifecycle_provider_returns_error(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	client.EXPECT().PlanAction(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.PlanAction_Response{
+		Diagnostics: []*proto.Diagnostic{
+			{
+				Severity: proto.Diagnostic_ERROR,
+				Summary:  "Provider error",
+				Detail:   "Something went wrong",
+			},
+		},
+	}, nil)
+
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "lifecycle",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		LinkedResources: []providers.LinkedResourcePlanData{
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+			},
+		},
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_planAction_linked_valid(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
 	}
-	resp := p.GetProviderSchema()
 
-	src := plans.NewChanges()
-	src.SyncWrapper().AppendResourceInstanceChange(&plans.ResourceInstanceChange{
-		Addr: addrs.AbsResourceInstance{
-			Module: addrs.RootModuleInstance,
-			Resource: addrs.ResourceInstance{
-				Resource: addrs.Resource{
-					Mode: addrs.ListResourceMode,
-					Type: "list",
-					Name: "test",
+	client.EXPECT().PlanAction(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.PlanAction_Response{
+		LinkedResources: []*proto.PlanAction_Response_LinkedResource{
+			{
+				PlannedState: &proto.DynamicValue{
+					Msgpack: []byte("\x81\xa4attr\xa3new"),
+				},
+				PlannedIdentity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Msgpack: []byte("\x81\xa7id_attr\xa2id"),
+					},
+				},
+			},
+			{
+				PlannedState: &proto.DynamicValue{
+					Msgpack: []byte("\x81\xa4attr\xa3new2"),
+				},
+				PlannedIdentity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Msgpack: []byte("\x81\xa7id_attr\xa2id2"),
+					},
 				},
-				Key: addrs.NoKey,
 			},
 		},
-		ProviderAddr: addrs.AbsProviderConfig{
-			Provider: p.Addr,
+	}, nil)
+
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "linked",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		LinkedResources: []providers.LinkedResourcePlanData{
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+			},
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+			},
 		},
-		Change: plans.Change{
-			Before: cty.NullVal(cty.Object(map[string]cty.Type{
-				"config": cty.Object(map[string]cty.Type{
-					"filter_attr": cty.String,
-				}),
-				"data": cty.List(cty.Object(map[string]cty.Type{
-					"state": cty.Object(map[string]cty.Type{
-						"resource_attr": cty.String,
-					}),
-					"identity": cty.Object(map[string]cty.Type{
-						"id_attr": cty.String,
-					}),
-				})),
-			})),
-			After: cty.ObjectVal(map[string]cty.Value{
-				"config": cty.ObjectVal(map[string]cty.Value{
-					"filter_attr": cty.StringVal("value"),
-				}),
-				"data": cty.ListVal([]cty.Value{
-					cty.ObjectVal(map[string]cty.Value{
-						"state": cty.ObjectVal(map[string]cty.Value{
-							"resource_attr": cty.StringVal("value"),
-						}),
-						"identity": cty.ObjectVal(map[string]cty.Value{
-							"id_attr": cty.StringVal("value"),
-						}),
-					}),
-				}),
-			}),
+	})
+
+	checkDiags(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_planAction_linked_provider_returns_too_few_linked_resources(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// The provider only returns one linked resource plan even though the
+	// schema declares two. We should still get the matched prefix back,
+	// alongside a diagnostic explaining which index is missing.
+	client.EXPECT().PlanAction(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.PlanAction_Response{
+		LinkedResources: []*proto.PlanAction_Response_LinkedResource{
+			{
+				PlannedState: &proto.DynamicValue{
+					Msgpack: []byte("\x81\xa4attr\xa3new"),
+				},
+				PlannedIdentity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Msgpack: []byte("\x81\xa7id_attr\xa2id"),
+					},
+				},
+			},
+		},
+	}, nil)
+
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "linked",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		LinkedResources: []providers.LinkedResourcePlanData{
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+			},
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+			},
+		},
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+	if len(resp.LinkedResources) != 1 {
+		t.Fatalf("expected the matched prefix of 1 linked resource, got %d", len(resp.LinkedResources))
+	}
+}
+
+func TestGRPCProvider_planAction_linked_provider_returns_too_many_linked_resources(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// The provider returns three linked resource plans even though the
+	// schema only declares two. We should still get the matched prefix of
+	// two back, alongside a diagnostic enumerating the unexpected index.
+	client.EXPECT().PlanAction(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.PlanAction_Response{
+		LinkedResources: []*proto.PlanAction_Response_LinkedResource{
+			{
+				PlannedState: &proto.DynamicValue{
+					Msgpack: []byte("\x81\xa4attr\xa3new"),
+				},
+				PlannedIdentity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Msgpack: []byte("\x81\xa7id_attr\xa2id"),
+					},
+				},
+			},
+			{
+				PlannedState: &proto.DynamicValue{
+					Msgpack: []byte("\x81\xa4attr\xa4new2"),
+				},
+				PlannedIdentity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Msgpack: []byte("\x81\xa7id_attr\xa3id2"),
+					},
+				},
+			},
+			{
+				PlannedState: &proto.DynamicValue{
+					Msgpack: []byte("\x81\xa4attr\xa4new3"),
+				},
+				PlannedIdentity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Msgpack: []byte("\x81\xa7id_attr\xa3id3"),
+					},
+				},
+			},
+		},
+	}, nil)
+
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "linked",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		LinkedResources: []providers.LinkedResourcePlanData{
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+			},
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+			},
+		},
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+	if len(resp.LinkedResources) != 2 {
+		t.Fatalf("expected the matched prefix of 2 linked resources, got %d", len(resp.LinkedResources))
+	}
+}
+
+func TestGRPCProvider_planAction_linked_missing_linked_resources(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// Missing LinkedResources for a linked action should error
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "linked",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_planAction_linked_too_few_linked_resources(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// Only one LinkedResource when two are required
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "linked",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		LinkedResources: []providers.LinkedResourcePlanData{
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+			},
 		},
 	})
-	_, err := src.Encode(&schemarepo.Schemas{
-		Providers: map[addrs.Provider]providers.ProviderSchema{
-			p.Addr: {
-				ResourceTypes:     resp.ResourceTypes,
-				ListResourceTypes: resp.ListResourceTypes,
+
+	checkDiagsHasError(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_planAction_linked_too_many_linked_resources(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// Three LinkedResources when two are required
+	resp := p.PlanAction(providers.PlanActionRequest{
+		ActionType: "linked",
+		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		LinkedResources: []providers.LinkedResourcePlanData{
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+			},
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+			},
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old3")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new3")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg3")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id3")}),
 			},
 		},
 	})
-	if err != nil {
-		t.Fatalf("unexpected error encoding changes: %s", err)
-	}
+
+	checkDiagsHasError(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_planAction_unlinked_valid(t *testing.T) {
+func TestGRPCProvider_planAction_linked_invalid_config(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	client.EXPECT().PlanAction(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.PlanAction_Response{}, nil)
-
+	// Invalid config for the action schema
 	resp := p.PlanAction(providers.PlanActionRequest{
-		ActionType: "unlinked",
+		ActionType: "linked",
 		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
+			"not_the_right_attr": cty.StringVal("foo"),
 		}),
+		LinkedResources: []providers.LinkedResourcePlanData{
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+			},
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+			},
+		},
 	})
 
-	checkDiags(t, resp.Diagnostics)
+	checkDiagsHasError(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_planAction_unlinked_valid_but_fails(t *testing.T) {
+func TestGRPCProvider_planAction_linked_provider_returns_error(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
@@ -1611,337 +5373,461 @@ func TestGRPCProvider_planAction_unlinked_valid_but_fails(t *testing.T) {
 		Diagnostics: []*proto.Diagnostic{
 			{
 				Severity: proto.Diagnostic_ERROR,
-				Summary:  "Boom",
-				Detail:   "Explosion",
+				Summary:  "Provider error",
+				Detail:   "Something went wrong",
 			},
 		},
 	}, nil)
 
 	resp := p.PlanAction(providers.PlanActionRequest{
-		ActionType: "unlinked",
+		ActionType: "linked",
 		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
 			"attr": cty.StringVal("foo"),
 		}),
+		LinkedResources: []providers.LinkedResourcePlanData{
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+			},
+			{
+				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
+				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
+				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
+				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+			},
+		},
 	})
 
 	checkDiagsHasError(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_planAction_unlinked_invalid_config(t *testing.T) {
-	client := mockProviderClient(t)
+func TestGRPCProvider_planAction_linked_missing_identity_schema(t *testing.T) {
 	p := &GRPCProvider{
-		client: client,
+		client: mockProviderClient(t),
 	}
 
 	resp := p.PlanAction(providers.PlanActionRequest{
-		ActionType: "unlinked",
+		ActionType: "linked_no_identity",
 		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
-			"not_the_right_attr": cty.StringVal("foo"),
+			"attr": cty.StringVal("foo"),
 		}),
+		LinkedResources: []providers.LinkedResourcePlanData{
+			{
+				PriorState:   cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState: cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:       cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+			},
+		},
 	})
 
 	checkDiagsHasError(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_planAction_unlinked_extra_linked_resources(t *testing.T) {
-	client := mockProviderClient(t)
+func TestGRPCProvider_ValidateActionLinkage_valid(t *testing.T) {
 	p := &GRPCProvider{
-		client: client,
+		client: mockProviderClient(t),
 	}
 
-	resp := p.PlanAction(providers.PlanActionRequest{
-		ActionType: "unlinked",
-		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
-		}),
-		LinkedResources: []providers.LinkedResourcePlanData{{
-			PriorState:    cty.NullVal(cty.DynamicPseudoType),
-			PlannedState:  cty.NullVal(cty.DynamicPseudoType),
-			Config:        cty.NullVal(cty.DynamicPseudoType),
-			PriorIdentity: cty.NullVal(cty.DynamicPseudoType),
-		}},
-	})
+	diags := p.ValidateActionLinkage("linked")
+	checkDiags(t, diags)
+}
 
-	checkDiagsHasError(t, resp.Diagnostics)
+func TestGRPCProvider_ValidateActionLinkage_missingIdentitySchema(t *testing.T) {
+	p := &GRPCProvider{
+		client: mockProviderClient(t),
+	}
+
+	diags := p.ValidateActionLinkage("linked_no_identity")
+	checkDiagsHasError(t, diags)
+	if got := diags.Err().Error(); !strings.Contains(got, "no_identity_resource") || !strings.Contains(got, "identity schema") {
+		t.Fatalf("expected a diagnostic naming no_identity_resource's missing identity schema, got: %s", got)
+	}
 }
 
-func TestGRPCProvider_planAction_unlinked_invalid_extra_returned_linked_resources(t *testing.T) {
+func TestGRPCProvider_ValidateActionLinkage_unknownActionType(t *testing.T) {
+	p := &GRPCProvider{
+		client: mockProviderClient(t),
+	}
+
+	diags := p.ValidateActionLinkage("does_not_exist")
+	checkDiagsHasError(t, diags)
+}
+
+func TestGRPCProvider_invokeAction_unlinked_valid(t *testing.T) {
+	ctrl := gomock.NewController(t)
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	plannedState := cty.ObjectVal(map[string]cty.Value{
-		"foo": cty.StringVal("bar"),
-	})
-	plannedStateMp, _ := msgpack.Marshal(plannedState, plannedState.Type())
-
-	client.EXPECT().PlanAction(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.PlanAction_Response{
-		LinkedResources: []*proto.PlanAction_Response_LinkedResource{
-			{
-				PlannedState: &proto.DynamicValue{
-					Msgpack: plannedStateMp,
-				},
+	mockInvokeClient := mockproto.NewMockProvider_InvokeActionClient(ctrl)
+	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
+		Type: &proto.InvokeAction_Event_Progress_{
+			Progress: &proto.InvokeAction_Event_Progress{
+				Message: "Hello from the action",
 			},
 		},
 	}, nil)
+	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
+		Type: &proto.InvokeAction_Event_Completed_{
+			Completed: &proto.InvokeAction_Event_Completed{},
+		},
+	}, nil)
+	mockInvokeClient.EXPECT().Recv().Return(nil, io.EOF)
 
-	resp := p.PlanAction(providers.PlanActionRequest{
+	client.EXPECT().InvokeAction(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(mockInvokeClient, nil)
+
+	resp := p.InvokeAction(providers.InvokeActionRequest{
 		ActionType: "unlinked",
-		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
 			"attr": cty.StringVal("foo"),
 		}),
 	})
 
-	checkDiagsHasError(t, resp.Diagnostics)
+	evts := []providers.InvokeActionEvent{}
+	for e := range resp.Events.Seq {
+		evts = append(evts, e)
+	}
+
+	if len(evts) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(evts))
+	}
+
+	checkDiags(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_planAction_lifecycle_valid(t *testing.T) {
+func TestGRPCProvider_invokeAction_unlinked_progress(t *testing.T) {
+	ctrl := gomock.NewController(t)
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	client.EXPECT().PlanAction(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.PlanAction_Response{
-		LinkedResources: []*proto.PlanAction_Response_LinkedResource{{
-			PlannedState: &proto.DynamicValue{
-				Msgpack: []byte("\x81\xa4attr\xa3new"),
+	mockInvokeClient := mockproto.NewMockProvider_InvokeActionClient(ctrl)
+	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
+		Type: &proto.InvokeAction_Event_Progress_{
+			Progress: &proto.InvokeAction_Event_Progress{
+				Message: "starting",
 			},
-			PlannedIdentity: &proto.ResourceIdentityData{
-				IdentityData: &proto.DynamicValue{
-					Msgpack: []byte("\x81\xa7id_attr\xa2id"),
-				},
+		},
+	}, nil)
+	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
+		Type: &proto.InvokeAction_Event_Progress_{
+			Progress: &proto.InvokeAction_Event_Progress{
+				Message: "still going",
 			},
-		}},
+		},
+	}, nil)
+	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
+		Type: &proto.InvokeAction_Event_Completed_{
+			Completed: &proto.InvokeAction_Event_Completed{},
+		},
 	}, nil)
+	mockInvokeClient.EXPECT().Recv().Return(nil, io.EOF)
 
-	resp := p.PlanAction(providers.PlanActionRequest{
-		ActionType: "lifecycle",
-		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+	client.EXPECT().InvokeAction(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(mockInvokeClient, nil)
+
+	resp := p.InvokeAction(providers.InvokeActionRequest{
+		ActionType: "unlinked",
+		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
 			"attr": cty.StringVal("foo"),
 		}),
-		LinkedResources: []providers.LinkedResourcePlanData{
-			{
-				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
-				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
-				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
-				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
-			},
-		},
 	})
 
+	var progressEvents []providers.InvokeActionEvent_Progress
+	for e := range resp.Events.Seq {
+		if p, ok := e.(providers.InvokeActionEvent_Progress); ok {
+			progressEvents = append(progressEvents, p)
+		}
+	}
+
+	if len(progressEvents) != 2 {
+		t.Fatalf("expected 2 progress events, got %d", len(progressEvents))
+	}
+	for _, p := range progressEvents {
+		if p.Fraction != -1 {
+			t.Fatalf("expected Fraction -1 (unknown) since the wire protocol doesn't report one, got %v", p.Fraction)
+		}
+	}
+
 	checkDiags(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_planAction_lifecycle_missing_linked_resource(t *testing.T) {
+// unlimitedInvokeActionStreamClient simulates a provider that never stops
+// sending progress events, so that breaking out of the consumer's range
+// loop early is the only thing that ends the stream.
+type unlimitedInvokeActionStreamClient struct {
+	proto.Provider_InvokeActionClient
+	recvCount int64
+}
+
+func (m *unlimitedInvokeActionStreamClient) Recv() (*proto.InvokeAction_Event, error) {
+	atomic.AddInt64(&m.recvCount, 1)
+	return &proto.InvokeAction_Event{
+		Type: &proto.InvokeAction_Event_Progress_{
+			Progress: &proto.InvokeAction_Event_Progress{
+				Message: "still going",
+			},
+		},
+	}, nil
+}
+
+func TestGRPCProvider_invokeAction_bufferedEarlyBreak(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
+		ctx:    context.Background(),
 	}
 
-	// Missing LinkedResources for a lifecycle action should error
-	resp := p.PlanAction(providers.PlanActionRequest{
-		ActionType: "lifecycle",
-		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+	stream := &unlimitedInvokeActionStreamClient{}
+	client.EXPECT().InvokeAction(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(stream, nil)
+
+	resp := p.InvokeAction(providers.InvokeActionRequest{
+		ActionType: "unlinked",
+		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
 			"attr": cty.StringVal("foo"),
 		}),
+		BufferSize: 2,
 	})
 
-	checkDiagsHasError(t, resp.Diagnostics)
+	var received int
+	for range resp.Events.Seq {
+		received++
+		if received == 3 {
+			break
+		}
+	}
+	if received != 3 {
+		t.Fatalf("expected to receive 3 events before breaking, got %d", received)
+	}
+
+	// The background goroutine should notice the consumer stopped iterating
+	// and stop calling Recv shortly after; it's allowed to have already
+	// buffered up to BufferSize events ahead of the consumer, but if it
+	// leaked it would keep calling Recv indefinitely since the stream never
+	// ends on its own.
+	n1 := atomic.LoadInt64(&stream.recvCount)
+	time.Sleep(50 * time.Millisecond)
+	n2 := atomic.LoadInt64(&stream.recvCount)
+	if n2 > n1+2 {
+		t.Fatalf("background goroutine kept receiving events after the consumer stopped iterating: %d -> %d", n1, n2)
+	}
 }
 
-func TestGRPCProvider_planAction_lifecycle_extra_linked_resource(t *testing.T) {
+// cancelAwareInvokeActionStreamClient simulates a provider stream that sends
+// a couple of progress events and then blocks waiting for the next one,
+// only returning (with the context's error) once its context is cancelled -
+// the same way a real gRPC stream unblocks Recv when its call is cancelled.
+type cancelAwareInvokeActionStreamClient struct {
+	proto.Provider_InvokeActionClient
+	ctx       context.Context
+	recvCount int64
+}
+
+func (m *cancelAwareInvokeActionStreamClient) Recv() (*proto.InvokeAction_Event, error) {
+	if atomic.AddInt64(&m.recvCount, 1) <= 2 {
+		return &proto.InvokeAction_Event{
+			Type: &proto.InvokeAction_Event_Progress_{
+				Progress: &proto.InvokeAction_Event_Progress{
+					Message: "still going",
+				},
+			},
+		}, nil
+	}
+	<-m.ctx.Done()
+	return nil, m.ctx.Err()
+}
+
+func TestGRPCProvider_invokeAction_Cancel(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
+		ctx:    context.Background(),
 	}
 
-	// Too many LinkedResources for a lifecycle action should error
-	resp := p.PlanAction(providers.PlanActionRequest{
-		ActionType: "lifecycle",
-		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+	stream := &cancelAwareInvokeActionStreamClient{}
+	client.EXPECT().InvokeAction(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(ctx context.Context, req *proto.InvokeAction_Request, opts ...grpc.CallOption) (proto.Provider_InvokeActionClient, error) {
+		stream.ctx = ctx
+		return stream, nil
+	})
+
+	resp := p.InvokeAction(providers.InvokeActionRequest{
+		ActionType: "unlinked",
+		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
 			"attr": cty.StringVal("foo"),
 		}),
-		LinkedResources: []providers.LinkedResourcePlanData{
-			{
-				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
-				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
-				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
-				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
-			},
-			{
-				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
-				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
-				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
-				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
-			},
-		},
 	})
 
-	checkDiagsHasError(t, resp.Diagnostics)
+	var progress, completed int
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for e := range resp.Events.Seq {
+			switch e.(type) {
+			case providers.InvokeActionEvent_Progress:
+				progress++
+			case providers.InvokeActionEvent_Completed:
+				completed++
+			}
+		}
+	}()
+
+	// Give the consumer a moment to read the two progress events and block
+	// waiting on the third; Cancel should make the provider's stream
+	// terminate rather than hang forever, even though nothing broke out of
+	// the range loop itself.
+	time.Sleep(50 * time.Millisecond)
+	resp.Events.Cancel()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("InvokeAction events did not stop after Cancel")
+	}
+
+	if progress != 2 {
+		t.Fatalf("expected 2 progress events before cancellation, got %d", progress)
+	}
+	if completed != 1 {
+		t.Fatalf("expected a single completed event carrying the cancellation error, got %d", completed)
+	}
 }
 
-func TestGRPCProvider_planAction_lifecycle_invalid_config(t *testing.T) {
+func TestGRPCProvider_invokeAction_unlinked_invalid(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	// Invalid config for the action schema
-	resp := p.PlanAction(providers.PlanActionRequest{
-		ActionType: "lifecycle",
-		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
-			"not_the_right_attr": cty.StringVal("foo"),
+	resp := p.InvokeAction(providers.InvokeActionRequest{
+		ActionType: "unlinked",
+		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
+			"not-defined": cty.StringVal("foo"),
 		}),
-		LinkedResources: []providers.LinkedResourcePlanData{
-			{
-				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
-				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
-				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
-				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
-			},
-		},
 	})
 
 	checkDiagsHasError(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_planAction_lifecycle_provider_returns_error(t *testing.T) {
+func TestGRPCProvider_invokeAction_unlinked_invalid_names_action_and_path(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	client.EXPECT().PlanAction(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.PlanAction_Response{
-		Diagnostics: []*proto.Diagnostic{
-			{
-				Severity: proto.Diagnostic_ERROR,
-				Summary:  "Provider error",
-				Detail:   "Something went wrong",
-			},
-		},
-	}, nil)
-
-	resp := p.PlanAction(providers.PlanActionRequest{
-		ActionType: "lifecycle",
-		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
+	resp := p.InvokeAction(providers.InvokeActionRequest{
+		ActionType: "unlinked",
+		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.NumberIntVal(1),
 		}),
-		LinkedResources: []providers.LinkedResourcePlanData{
-			{
-				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
-				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
-				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
-				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
-			},
-		},
 	})
 
 	checkDiagsHasError(t, resp.Diagnostics)
+	err := resp.Diagnostics.Err().Error()
+	if !strings.Contains(err, `"unlinked"`) {
+		t.Errorf("expected diagnostic to name the action type, got: %s", err)
+	}
+	if !strings.Contains(err, ".attr") {
+		t.Errorf("expected diagnostic to name the non-conforming attribute, got: %s", err)
+	}
 }
 
-func TestGRPCProvider_planAction_linked_valid(t *testing.T) {
+func TestGRPCProvider_invokeAction_lifecycle_valid(t *testing.T) {
+	ctrl := gomock.NewController(t)
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	client.EXPECT().PlanAction(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.PlanAction_Response{
-		LinkedResources: []*proto.PlanAction_Response_LinkedResource{
-			{
-				PlannedState: &proto.DynamicValue{
-					Msgpack: []byte("\x81\xa4attr\xa3new"),
-				},
-				PlannedIdentity: &proto.ResourceIdentityData{
-					IdentityData: &proto.DynamicValue{
-						Msgpack: []byte("\x81\xa7id_attr\xa2id"),
-					},
-				},
+	mockInvokeClient := mockproto.NewMockProvider_InvokeActionClient(ctrl)
+	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
+		Type: &proto.InvokeAction_Event_Progress_{
+			Progress: &proto.InvokeAction_Event_Progress{
+				Message: "Lifecycle progress",
 			},
-			{
-				PlannedState: &proto.DynamicValue{
-					Msgpack: []byte("\x81\xa4attr\xa3new2"),
-				},
-				PlannedIdentity: &proto.ResourceIdentityData{
-					IdentityData: &proto.DynamicValue{
-						Msgpack: []byte("\x81\xa7id_attr\xa2id2"),
+		},
+	}, nil)
+	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
+		Type: &proto.InvokeAction_Event_Completed_{
+			Completed: &proto.InvokeAction_Event_Completed{
+				LinkedResources: []*proto.InvokeAction_Event_Completed_LinkedResource{{
+					NewState: &proto.DynamicValue{
+						Msgpack: []byte("\x81\xa4attr\xa3new"),
 					},
-				},
+					NewIdentity: &proto.ResourceIdentityData{
+						IdentityData: &proto.DynamicValue{
+							Msgpack: []byte("\x81\xa7id_attr\xa2id"),
+						},
+					},
+				}},
 			},
 		},
 	}, nil)
+	mockInvokeClient.EXPECT().Recv().Return(nil, io.EOF)
 
-	resp := p.PlanAction(providers.PlanActionRequest{
-		ActionType: "linked",
-		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+	client.EXPECT().InvokeAction(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(mockInvokeClient, nil)
+
+	resp := p.InvokeAction(providers.InvokeActionRequest{
+		ActionType: "lifecycle",
+		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
 			"attr": cty.StringVal("foo"),
 		}),
-		LinkedResources: []providers.LinkedResourcePlanData{
-			{
-				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
-				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
-				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
-				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
-			},
+		LinkedResources: []providers.LinkedResourceInvokeData{
 			{
-				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
-				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
-				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
-				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
 			},
 		},
 	})
 
-	checkDiags(t, resp.Diagnostics)
-}
-
-func TestGRPCProvider_planAction_linked_missing_linked_resources(t *testing.T) {
-	client := mockProviderClient(t)
-	p := &GRPCProvider{
-		client: client,
+	evts := []providers.InvokeActionEvent{}
+	for e := range resp.Events.Seq {
+		evts = append(evts, e)
 	}
 
-	// Missing LinkedResources for a linked action should error
-	resp := p.PlanAction(providers.PlanActionRequest{
-		ActionType: "linked",
-		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
-		}),
-	})
+	if len(evts) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(evts))
+	}
 
-	checkDiagsHasError(t, resp.Diagnostics)
+	checkDiags(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_planAction_linked_too_few_linked_resources(t *testing.T) {
+func TestGRPCProvider_invokeAction_lifecycle_invalid_config(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	// Only one LinkedResource when two are required
-	resp := p.PlanAction(providers.PlanActionRequest{
-		ActionType: "linked",
-		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
+	resp := p.InvokeAction(providers.InvokeActionRequest{
+		ActionType: "lifecycle",
+		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
+			"not-defined": cty.StringVal("foo"),
 		}),
-		LinkedResources: []providers.LinkedResourcePlanData{
+		LinkedResources: []providers.LinkedResourceInvokeData{
 			{
-				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
-				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
-				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
-				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
 			},
 		},
 	})
@@ -1949,67 +5835,47 @@ func TestGRPCProvider_planAction_linked_too_few_linked_resources(t *testing.T) {
 	checkDiagsHasError(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_planAction_linked_too_many_linked_resources(t *testing.T) {
+func TestGRPCProvider_invokeAction_lifecycle_missing_linked_resource(t *testing.T) {
 	client := mockProviderClient(t)
-	p := &GRPCProvider{
-		client: client,
-	}
-
-	// Three LinkedResources when two are required
-	resp := p.PlanAction(providers.PlanActionRequest{
-		ActionType: "linked",
-		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
-		}),
-		LinkedResources: []providers.LinkedResourcePlanData{
-			{
-				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
-				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
-				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
-				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
-			},
-			{
-				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
-				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
-				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
-				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
-			},
-			{
-				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old3")}),
-				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new3")}),
-				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg3")}),
-				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id3")}),
-			},
-		},
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// Missing LinkedResources for a lifecycle action should error
+	resp := p.InvokeAction(providers.InvokeActionRequest{
+		ActionType: "lifecycle",
+		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
 	})
 
 	checkDiagsHasError(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_planAction_linked_invalid_config(t *testing.T) {
+func TestGRPCProvider_invokeAction_lifecycle_extra_linked_resource(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	// Invalid config for the action schema
-	resp := p.PlanAction(providers.PlanActionRequest{
-		ActionType: "linked",
-		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
-			"not_the_right_attr": cty.StringVal("foo"),
+	// Too many LinkedResources for a lifecycle action should error
+	resp := p.InvokeAction(providers.InvokeActionRequest{
+		ActionType: "lifecycle",
+		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
 		}),
-		LinkedResources: []providers.LinkedResourcePlanData{
+		LinkedResources: []providers.LinkedResourceInvokeData{
 			{
-				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
-				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
-				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
-				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
 			},
 			{
-				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
-				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
-				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
-				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
+				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
+				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
+				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
 			},
 		},
 	})
@@ -2017,50 +5883,71 @@ func TestGRPCProvider_planAction_linked_invalid_config(t *testing.T) {
 	checkDiagsHasError(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_planAction_linked_provider_returns_error(t *testing.T) {
+func TestGRPCProvider_invokeAction_lifecycle_provider_returns_error(t *testing.T) {
+	ctrl := gomock.NewController(t)
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	client.EXPECT().PlanAction(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(&proto.PlanAction_Response{
-		Diagnostics: []*proto.Diagnostic{
-			{
-				Severity: proto.Diagnostic_ERROR,
-				Summary:  "Provider error",
-				Detail:   "Something went wrong",
+	mockInvokeClient := mockproto.NewMockProvider_InvokeActionClient(ctrl)
+	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
+		Type: &proto.InvokeAction_Event_Completed_{
+			Completed: &proto.InvokeAction_Event_Completed{
+				Diagnostics: []*proto.Diagnostic{
+					{
+						Severity: proto.Diagnostic_ERROR,
+						Summary:  "Provider error",
+						Detail:   "Something went wrong",
+					},
+				},
 			},
 		},
 	}, nil)
 
-	resp := p.PlanAction(providers.PlanActionRequest{
-		ActionType: "linked",
-		ProposedActionData: cty.ObjectVal(map[string]cty.Value{
+	mockInvokeClient.EXPECT().Recv().Return(nil, io.EOF)
+
+	client.EXPECT().InvokeAction(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(mockInvokeClient, nil)
+
+	resp := p.InvokeAction(providers.InvokeActionRequest{
+		ActionType: "lifecycle",
+		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
 			"attr": cty.StringVal("foo"),
 		}),
-		LinkedResources: []providers.LinkedResourcePlanData{
-			{
-				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
-				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
-				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
-				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
-			},
+		LinkedResources: []providers.LinkedResourceInvokeData{
 			{
-				PriorState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
-				PlannedState:  cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
-				Config:        cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
-				PriorIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
 			},
 		},
 	})
 
-	checkDiagsHasError(t, resp.Diagnostics)
+	// The resp.Diagnostics only fail if the call could not be made
+	checkDiags(t, resp.Diagnostics)
+
+	evts := []providers.InvokeActionEvent{}
+	for e := range resp.Events.Seq {
+		evts = append(evts, e)
+	}
+
+	if len(evts) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(evts))
+	}
+
+	evt, ok := evts[0].(providers.InvokeActionEvent_Completed)
+	if !ok {
+		t.Fatalf("expected completed event, got %T", evts[0])
+	}
+
+	checkDiagsHasError(t, evt.Diagnostics)
 }
 
-func TestGRPCProvider_invokeAction_unlinked_valid(t *testing.T) {
+func TestGRPCProvider_invokeAction_linked_valid(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
@@ -2071,13 +5958,36 @@ func TestGRPCProvider_invokeAction_unlinked_valid(t *testing.T) {
 	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
 		Type: &proto.InvokeAction_Event_Progress_{
 			Progress: &proto.InvokeAction_Event_Progress{
-				Message: "Hello from the action",
+				Message: "Linked progress",
 			},
 		},
 	}, nil)
 	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
 		Type: &proto.InvokeAction_Event_Completed_{
-			Completed: &proto.InvokeAction_Event_Completed{},
+			Completed: &proto.InvokeAction_Event_Completed{
+				LinkedResources: []*proto.InvokeAction_Event_Completed_LinkedResource{
+					{
+						NewState: &proto.DynamicValue{
+							Msgpack: []byte("\x81\xa4attr\xa3new"),
+						},
+						NewIdentity: &proto.ResourceIdentityData{
+							IdentityData: &proto.DynamicValue{
+								Msgpack: []byte("\x81\xa7id_attr\xa2id"),
+							},
+						},
+					},
+					{
+						NewState: &proto.DynamicValue{
+							Msgpack: []byte("\x81\xa4attr\xa4new2"),
+						},
+						NewIdentity: &proto.ResourceIdentityData{
+							IdentityData: &proto.DynamicValue{
+								Msgpack: []byte("\x81\xa7id_attr\xa3id2"),
+							},
+						},
+					},
+				},
+			},
 		},
 	}, nil)
 	mockInvokeClient.EXPECT().Recv().Return(nil, io.EOF)
@@ -2088,14 +5998,28 @@ func TestGRPCProvider_invokeAction_unlinked_valid(t *testing.T) {
 	).Return(mockInvokeClient, nil)
 
 	resp := p.InvokeAction(providers.InvokeActionRequest{
-		ActionType: "unlinked",
+		ActionType: "linked",
 		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
 			"attr": cty.StringVal("foo"),
 		}),
+		LinkedResources: []providers.LinkedResourceInvokeData{
+			{
+				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+			},
+			{
+				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
+				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
+				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
+				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+			},
+		},
 	})
 
 	evts := []providers.InvokeActionEvent{}
-	for e := range resp.Events {
+	for e := range resp.Events.Seq {
 		evts = append(evts, e)
 	}
 
@@ -2106,50 +6030,131 @@ func TestGRPCProvider_invokeAction_unlinked_valid(t *testing.T) {
 	checkDiags(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_invokeAction_unlinked_invalid(t *testing.T) {
+func TestGRPCProvider_invokeAction_linked_provider_returns_too_few_linked_resources(t *testing.T) {
+	ctrl := gomock.NewController(t)
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
+	// The provider only returns one linked resource result even though the
+	// schema declares two. We should still get the matched prefix back,
+	// alongside a diagnostic explaining which index is missing.
+	mockInvokeClient := mockproto.NewMockProvider_InvokeActionClient(ctrl)
+	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
+		Type: &proto.InvokeAction_Event_Completed_{
+			Completed: &proto.InvokeAction_Event_Completed{
+				LinkedResources: []*proto.InvokeAction_Event_Completed_LinkedResource{
+					{
+						NewState: &proto.DynamicValue{
+							Msgpack: []byte("\x81\xa4attr\xa3new"),
+						},
+						NewIdentity: &proto.ResourceIdentityData{
+							IdentityData: &proto.DynamicValue{
+								Msgpack: []byte("\x81\xa7id_attr\xa2id"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	mockInvokeClient.EXPECT().Recv().Return(nil, io.EOF)
+
+	client.EXPECT().InvokeAction(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(mockInvokeClient, nil)
+
 	resp := p.InvokeAction(providers.InvokeActionRequest{
-		ActionType: "unlinked",
+		ActionType: "linked",
 		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
-			"not-defined": cty.StringVal("foo"),
+			"attr": cty.StringVal("foo"),
 		}),
+		LinkedResources: []providers.LinkedResourceInvokeData{
+			{
+				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+			},
+			{
+				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
+				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
+				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
+				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+			},
+		},
 	})
 
-	checkDiagsHasError(t, resp.Diagnostics)
+	checkDiags(t, resp.Diagnostics)
+
+	evts := []providers.InvokeActionEvent{}
+	for e := range resp.Events.Seq {
+		evts = append(evts, e)
+	}
+
+	if len(evts) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(evts))
+	}
+
+	evt, ok := evts[0].(providers.InvokeActionEvent_Completed)
+	if !ok {
+		t.Fatalf("expected completed event, got %T", evts[0])
+	}
+
+	checkDiagsHasError(t, evt.Diagnostics)
+	if len(evt.LinkedResources) != 1 {
+		t.Fatalf("expected the matched prefix of 1 linked resource, got %d", len(evt.LinkedResources))
+	}
 }
 
-func TestGRPCProvider_invokeAction_lifecycle_valid(t *testing.T) {
+func TestGRPCProvider_invokeAction_linked_provider_returns_too_many_linked_resources(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
+	// The provider returns three linked resource results even though the
+	// schema only declares two. We should still get the matched prefix of
+	// two back, alongside a diagnostic enumerating the unexpected index.
 	mockInvokeClient := mockproto.NewMockProvider_InvokeActionClient(ctrl)
-	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
-		Type: &proto.InvokeAction_Event_Progress_{
-			Progress: &proto.InvokeAction_Event_Progress{
-				Message: "Lifecycle progress",
-			},
-		},
-	}, nil)
 	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
 		Type: &proto.InvokeAction_Event_Completed_{
 			Completed: &proto.InvokeAction_Event_Completed{
-				LinkedResources: []*proto.InvokeAction_Event_Completed_LinkedResource{{
-					NewState: &proto.DynamicValue{
-						Msgpack: []byte("\x81\xa4attr\xa3new"),
+				LinkedResources: []*proto.InvokeAction_Event_Completed_LinkedResource{
+					{
+						NewState: &proto.DynamicValue{
+							Msgpack: []byte("\x81\xa4attr\xa3new"),
+						},
+						NewIdentity: &proto.ResourceIdentityData{
+							IdentityData: &proto.DynamicValue{
+								Msgpack: []byte("\x81\xa7id_attr\xa2id"),
+							},
+						},
 					},
-					NewIdentity: &proto.ResourceIdentityData{
-						IdentityData: &proto.DynamicValue{
-							Msgpack: []byte("\x81\xa7id_attr\xa2id"),
+					{
+						NewState: &proto.DynamicValue{
+							Msgpack: []byte("\x81\xa4attr\xa4new2"),
+						},
+						NewIdentity: &proto.ResourceIdentityData{
+							IdentityData: &proto.DynamicValue{
+								Msgpack: []byte("\x81\xa7id_attr\xa3id2"),
+							},
+						},
+					},
+					{
+						NewState: &proto.DynamicValue{
+							Msgpack: []byte("\x81\xa4attr\xa4new3"),
+						},
+						NewIdentity: &proto.ResourceIdentityData{
+							IdentityData: &proto.DynamicValue{
+								Msgpack: []byte("\x81\xa7id_attr\xa3id3"),
+							},
 						},
 					},
-				}},
+				},
 			},
 		},
 	}, nil)
@@ -2161,7 +6166,7 @@ func TestGRPCProvider_invokeAction_lifecycle_valid(t *testing.T) {
 	).Return(mockInvokeClient, nil)
 
 	resp := p.InvokeAction(providers.InvokeActionRequest{
-		ActionType: "lifecycle",
+		ActionType: "linked",
 		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
 			"attr": cty.StringVal("foo"),
 		}),
@@ -2172,29 +6177,45 @@ func TestGRPCProvider_invokeAction_lifecycle_valid(t *testing.T) {
 				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
 				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
 			},
+			{
+				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
+				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
+				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
+				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+			},
 		},
 	})
 
+	checkDiags(t, resp.Diagnostics)
+
 	evts := []providers.InvokeActionEvent{}
-	for e := range resp.Events {
+	for e := range resp.Events.Seq {
 		evts = append(evts, e)
 	}
 
-	if len(evts) != 2 {
-		t.Fatalf("expected 2 events, got %d", len(evts))
+	if len(evts) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(evts))
 	}
 
-	checkDiags(t, resp.Diagnostics)
+	evt, ok := evts[0].(providers.InvokeActionEvent_Completed)
+	if !ok {
+		t.Fatalf("expected completed event, got %T", evts[0])
+	}
+
+	checkDiagsHasError(t, evt.Diagnostics)
+	if len(evt.LinkedResources) != 2 {
+		t.Fatalf("expected the matched prefix of 2 linked resources, got %d", len(evt.LinkedResources))
+	}
 }
 
-func TestGRPCProvider_invokeAction_lifecycle_invalid_config(t *testing.T) {
+func TestGRPCProvider_invokeAction_linked_invalid_config(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
 	resp := p.InvokeAction(providers.InvokeActionRequest{
-		ActionType: "lifecycle",
+		ActionType: "linked",
 		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
 			"not-defined": cty.StringVal("foo"),
 		}),
@@ -2205,21 +6226,27 @@ func TestGRPCProvider_invokeAction_lifecycle_invalid_config(t *testing.T) {
 				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
 				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
 			},
+			{
+				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
+				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
+				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
+				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+			},
 		},
 	})
 
 	checkDiagsHasError(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_invokeAction_lifecycle_missing_linked_resource(t *testing.T) {
+func TestGRPCProvider_invokeAction_linked_missing_linked_resources(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	// Missing LinkedResources for a lifecycle action should error
+	// Missing LinkedResources for a linked action should error
 	resp := p.InvokeAction(providers.InvokeActionRequest{
-		ActionType: "lifecycle",
+		ActionType: "linked",
 		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
 			"attr": cty.StringVal("foo"),
 		}),
@@ -2228,15 +6255,40 @@ func TestGRPCProvider_invokeAction_lifecycle_missing_linked_resource(t *testing.
 	checkDiagsHasError(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_invokeAction_lifecycle_extra_linked_resource(t *testing.T) {
+func TestGRPCProvider_invokeAction_linked_too_few_linked_resources(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 	}
 
-	// Too many LinkedResources for a lifecycle action should error
+	// Only one LinkedResource when two are required
 	resp := p.InvokeAction(providers.InvokeActionRequest{
-		ActionType: "lifecycle",
+		ActionType: "linked",
+		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		LinkedResources: []providers.LinkedResourceInvokeData{
+			{
+				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
+				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
+				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
+				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+			},
+		},
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_invokeAction_linked_too_many_linked_resources(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// Three LinkedResources when two are required
+	resp := p.InvokeAction(providers.InvokeActionRequest{
+		ActionType: "linked",
 		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
 			"attr": cty.StringVal("foo"),
 		}),
@@ -2253,13 +6305,19 @@ func TestGRPCProvider_invokeAction_lifecycle_extra_linked_resource(t *testing.T)
 				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
 				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
 			},
+			{
+				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old3")}),
+				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new3")}),
+				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg3")}),
+				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id3")}),
+			},
 		},
 	})
 
 	checkDiagsHasError(t, resp.Diagnostics)
 }
 
-func TestGRPCProvider_invokeAction_lifecycle_provider_returns_error(t *testing.T) {
+func TestGRPCProvider_invokeAction_linked_provider_returns_error(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
@@ -2289,7 +6347,7 @@ func TestGRPCProvider_invokeAction_lifecycle_provider_returns_error(t *testing.T
 	).Return(mockInvokeClient, nil)
 
 	resp := p.InvokeAction(providers.InvokeActionRequest{
-		ActionType: "lifecycle",
+		ActionType: "linked",
 		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
 			"attr": cty.StringVal("foo"),
 		}),
@@ -2300,6 +6358,12 @@ func TestGRPCProvider_invokeAction_lifecycle_provider_returns_error(t *testing.T
 				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
 				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
 			},
+			{
+				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
+				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
+				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
+				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+			},
 		},
 	})
 
@@ -2307,7 +6371,7 @@ func TestGRPCProvider_invokeAction_lifecycle_provider_returns_error(t *testing.T
 	checkDiags(t, resp.Diagnostics)
 
 	evts := []providers.InvokeActionEvent{}
-	for e := range resp.Events {
+	for e := range resp.Events.Seq {
 		evts = append(evts, e)
 	}
 
@@ -2323,293 +6387,556 @@ func TestGRPCProvider_invokeAction_lifecycle_provider_returns_error(t *testing.T
 	checkDiagsHasError(t, evt.Diagnostics)
 }
 
-func TestGRPCProvider_invokeAction_linked_valid(t *testing.T) {
-	ctrl := gomock.NewController(t)
+// Mock implementation of the ListResource stream client
+type mockListResourceStreamClient struct {
+	events  []*proto.ListResource_Event
+	current int
+	proto.Provider_ListResourceClient
+}
+
+func (m *mockListResourceStreamClient) Recv() (*proto.ListResource_Event, error) {
+	if m.current >= len(m.events) {
+		return nil, io.EOF
+	}
+
+	event := m.events[m.current]
+	m.current++
+	return event, nil
+}
+
+// wrappedEOFListResourceStreamClient simulates a gRPC wrapper that doesn't
+// return io.EOF itself but an error wrapping it, to exercise the
+// errors.Is(err, io.EOF) check rather than a bare equality comparison.
+type wrappedEOFListResourceStreamClient struct {
+	events  []*proto.ListResource_Event
+	current int
+	proto.Provider_ListResourceClient
+}
+
+func (m *wrappedEOFListResourceStreamClient) Recv() (*proto.ListResource_Event, error) {
+	if m.current >= len(m.events) {
+		return nil, fmt.Errorf("rpc error: %w", io.EOF)
+	}
+
+	event := m.events[m.current]
+	m.current++
+	return event, nil
+}
+
+// blockingListResourceStreamClient simulates a long-running ListResource
+// stream whose Recv blocks until its context is done, the way a real
+// streaming RPC would abort once CancelInflight cancels it mid-flight.
+type blockingListResourceStreamClient struct {
+	ctx context.Context
+	proto.Provider_ListResourceClient
+}
+
+func (m *blockingListResourceStreamClient) Recv() (*proto.ListResource_Event, error) {
+	<-m.ctx.Done()
+	return nil, m.ctx.Err()
+}
+
+func TestGRPCProvider_ListResource_CancelInflight(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
+		ctx:    context.Background(),
 	}
 
-	mockInvokeClient := mockproto.NewMockProvider_InvokeActionClient(ctrl)
-	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
-		Type: &proto.InvokeAction_Event_Progress_{
-			Progress: &proto.InvokeAction_Event_Progress{
-				Message: "Linked progress",
-			},
-		},
-	}, nil)
-	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
-		Type: &proto.InvokeAction_Event_Completed_{
-			Completed: &proto.InvokeAction_Event_Completed{
-				LinkedResources: []*proto.InvokeAction_Event_Completed_LinkedResource{
-					{
-						NewState: &proto.DynamicValue{
-							Msgpack: []byte("\x81\xa4attr\xa3new"),
-						},
-						NewIdentity: &proto.ResourceIdentityData{
-							IdentityData: &proto.DynamicValue{
-								Msgpack: []byte("\x81\xa7id_attr\xa2id"),
-							},
-						},
-					},
-					{
-						NewState: &proto.DynamicValue{
-							Msgpack: []byte("\x81\xa4attr\xa4new2"),
-						},
-						NewIdentity: &proto.ResourceIdentityData{
-							IdentityData: &proto.DynamicValue{
-								Msgpack: []byte("\x81\xa7id_attr\xa3id2"),
-							},
-						},
-					},
-				},
-			},
-		},
-	}, nil)
-	mockInvokeClient.EXPECT().Recv().Return(nil, io.EOF)
-
-	client.EXPECT().InvokeAction(
+	started := make(chan struct{})
+	client.EXPECT().ListResource(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(mockInvokeClient, nil)
+	).DoAndReturn(func(ctx context.Context, req *proto.ListResource_Request, opts ...grpc.CallOption) (proto.Provider_ListResourceClient, error) {
+		close(started)
+		return &blockingListResourceStreamClient{ctx: ctx}, nil
+	})
 
-	resp := p.InvokeAction(providers.InvokeActionRequest{
-		ActionType: "linked",
-		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
+	configVal := cty.ObjectVal(map[string]cty.Value{
+		"config": cty.ObjectVal(map[string]cty.Value{
+			"filter_attr": cty.StringVal("filter-value"),
 		}),
-		LinkedResources: []providers.LinkedResourceInvokeData{
-			{
-				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
-				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
-				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
-				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
-			},
-			{
-				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
-				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
-				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
-				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
-			},
-		},
 	})
 
-	evts := []providers.InvokeActionEvent{}
-	for e := range resp.Events {
-		evts = append(evts, e)
+	done := make(chan providers.ListResourceResponse, 1)
+	go func() {
+		done <- p.ListResource(providers.ListResourceRequest{
+			TypeName: "list",
+			Config:   configVal,
+			Limit:    100,
+		})
+	}()
+
+	<-started
+	p.CancelInflight()
+
+	select {
+	case resp := <-done:
+		checkDiagsHasError(t, resp.Diagnostics)
+	case <-time.After(5 * time.Second):
+		t.Fatal("ListResource did not return after CancelInflight")
 	}
+}
 
-	if len(evts) != 2 {
-		t.Fatalf("expected 2 events, got %d", len(evts))
+func TestGRPCProvider_acquireConcurrencySlot(t *testing.T) {
+	t.Run("no limit configured: never blocks", func(t *testing.T) {
+		p := &GRPCProvider{}
+
+		release, err := p.acquireConcurrencySlot(context.Background(), "unlimited")
+		if err != nil {
+			t.Fatal(err)
+		}
+		release()
+	})
+
+	t.Run("bounds concurrent holders to the configured limit", func(t *testing.T) {
+		p := &GRPCProvider{
+			ConcurrencyLimits: map[string]int{"limited": 2},
+		}
+
+		const goroutines = 5
+		var maxSeen atomic.Int32
+		var inFlight atomic.Int32
+		acquired := make(chan struct{}, goroutines)
+		holdRelease := make(chan struct{})
+
+		var wg sync.WaitGroup
+		for i := 0; i < goroutines; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				release, err := p.acquireConcurrencySlot(context.Background(), "limited")
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				acquired <- struct{}{}
+
+				cur := inFlight.Add(1)
+				for {
+					old := maxSeen.Load()
+					if cur <= old || maxSeen.CompareAndSwap(old, cur) {
+						break
+					}
+				}
+
+				<-holdRelease
+				inFlight.Add(-1)
+				release()
+			}()
+		}
+
+		for i := 0; i < 2; i++ {
+			<-acquired
+		}
+		select {
+		case <-acquired:
+			t.Fatal("a third goroutine acquired a slot before any were released")
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		close(holdRelease)
+		wg.Wait()
+
+		if got := maxSeen.Load(); got > 2 {
+			t.Fatalf("expected at most 2 concurrent holders, saw %d", got)
+		}
+	})
+
+	t.Run("ctx done while waiting for a slot returns an error", func(t *testing.T) {
+		p := &GRPCProvider{
+			ConcurrencyLimits: map[string]int{"limited": 1},
+		}
+
+		release, err := p.acquireConcurrencySlot(context.Background(), "limited")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer release()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if _, err := p.acquireConcurrencySlot(ctx, "limited"); err == nil {
+			t.Fatal("expected an error when ctx is already done")
+		}
+	})
+}
+
+func TestGRPCProvider_encodeDynamicValuePooled(t *testing.T) {
+	p := &GRPCProvider{}
+	ty := cty.Object(map[string]cty.Type{"id": cty.String})
+	val := cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("foo")})
+
+	want, err := msgpack.Marshal(val, ty)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dv, release, err := p.encodeDynamicValuePooled(val, ty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dv.Msgpack, want) {
+		t.Fatalf("got %q, want %q", dv.Msgpack, want)
+	}
+	release()
+
+	// A second call should reuse the buffer released above rather than
+	// growing it further, and still produce a correct encoding.
+	dv2, release2, err := p.encodeDynamicValuePooled(val, ty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dv2.Msgpack, want) {
+		t.Fatalf("got %q, want %q", dv2.Msgpack, want)
+	}
+	release2()
+}
+
+func TestGRPCProvider_encodeDynamicValuePooled_concurrent(t *testing.T) {
+	p := &GRPCProvider{}
+	ty := cty.Object(map[string]cty.Type{"id": cty.String})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			val := cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal(fmt.Sprintf("value-%d", i))})
+			want, err := msgpack.Marshal(val, ty)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			dv, release, err := p.encodeDynamicValuePooled(val, ty)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer release()
+
+			if !bytes.Equal(dv.Msgpack, want) {
+				t.Errorf("got %q, want %q", dv.Msgpack, want)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+// BenchmarkGRPCProvider_encodeDynamicValue reports the allocation cost of
+// the unpooled helper used by most call sites.
+func BenchmarkGRPCProvider_encodeDynamicValue(b *testing.B) {
+	p := &GRPCProvider{}
+	ty := cty.Object(map[string]cty.Type{"id": cty.String, "name": cty.String})
+	val := cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("foo"), "name": cty.StringVal("bar")})
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := p.encodeDynamicValue(val, ty); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkGRPCProvider_encodeDynamicValuePooled reports the allocation cost
+// of the pooled helper used for per-resource CRUD calls, which should settle
+// to fewer allocations per op than BenchmarkGRPCProvider_encodeDynamicValue
+// once the pooled buffer has grown to fit.
+func BenchmarkGRPCProvider_encodeDynamicValuePooled(b *testing.B) {
+	p := &GRPCProvider{}
+	ty := cty.Object(map[string]cty.Type{"id": cty.String, "name": cty.String})
+	val := cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("foo"), "name": cty.StringVal("bar")})
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, release, err := p.encodeDynamicValuePooled(val, ty)
+		if err != nil {
+			b.Fatal(err)
+		}
+		release()
+	}
+}
+
+// fakeResourceSchemas builds n distinctly-named resource schemas, each with
+// an identity schema, for exercising convertResourceSchemas at scale.
+func fakeResourceSchemas(n int) (map[string]*proto.Schema, map[string]*proto.ResourceIdentitySchema) {
+	resourceSchemas := make(map[string]*proto.Schema, n)
+	identitySchemas := make(map[string]*proto.ResourceIdentitySchema, n)
+	for i := 0; i < n; i++ {
+		name := fmt.Sprintf("test_resource_%d", i)
+		resourceSchemas[name] = &proto.Schema{
+			Block: &proto.Schema_Block{
+				Attributes: []*proto.Schema_Attribute{
+					{Name: "id", Type: []byte(`"string"`), Computed: true},
+					{Name: "name", Type: []byte(`"string"`), Optional: true},
+				},
+			},
+		}
+		identitySchemas[name] = &proto.ResourceIdentitySchema{
+			IdentityAttributes: []*proto.ResourceIdentitySchema_IdentityAttribute{
+				{Name: "id", Type: []byte(`"string"`), RequiredForImport: true},
+			},
+		}
+	}
+	return resourceSchemas, identitySchemas
+}
+
+func TestConvertResourceSchemas(t *testing.T) {
+	resourceSchemas, identitySchemas := fakeResourceSchemas(500)
+
+	got := convertResourceSchemas(resourceSchemas, identitySchemas)
+
+	if len(got) != len(resourceSchemas) {
+		t.Fatalf("expected %d converted schemas, got %d", len(resourceSchemas), len(got))
 	}
+	for name, res := range resourceSchemas {
+		want := convert.ProtoToProviderSchema(res, identitySchemas[name])
+		if !cmp.Equal(want, got[name], typeComparer, valueComparer, equateEmpty) {
+			t.Fatalf("schema for %s doesn't match serial conversion\n%s", name, cmp.Diff(want, got[name], typeComparer, valueComparer, equateEmpty))
+		}
+	}
+}
 
-	checkDiags(t, resp.Diagnostics)
+// BenchmarkConvertResourceSchemas_500Types measures the cost of converting
+// a provider schema with 500 resource types, the case the bounded worker
+// pool in convertResourceSchemas exists to speed up.
+func BenchmarkConvertResourceSchemas_500Types(b *testing.B) {
+	resourceSchemas, identitySchemas := fakeResourceSchemas(500)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		convertResourceSchemas(resourceSchemas, identitySchemas)
+	}
 }
 
-func TestGRPCProvider_invokeAction_linked_invalid_config(t *testing.T) {
+func TestGRPCProvider_ListResource(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
+		ctx:    context.Background(),
 	}
 
-	resp := p.InvokeAction(providers.InvokeActionRequest{
-		ActionType: "linked",
-		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
-			"not-defined": cty.StringVal("foo"),
-		}),
-		LinkedResources: []providers.LinkedResourceInvokeData{
+	// Create a mock stream client that will return resource events
+	mockStream := &mockListResourceStreamClient{
+		events: []*proto.ListResource_Event{
 			{
-				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
-				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
-				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
-				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
+				DisplayName: "Test Resource 1",
+				Identity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Msgpack: []byte("\x81\xa7id_attr\xa4id-1"),
+					},
+				},
 			},
 			{
-				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
-				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
-				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
-				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
+				DisplayName: "Test Resource 2",
+				Identity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Msgpack: []byte("\x81\xa7id_attr\xa4id-2"),
+					},
+				},
+				ResourceObject: &proto.DynamicValue{
+					Msgpack: []byte("\x81\xadresource_attr\xa5value"),
+				},
 			},
 		},
-	})
-
-	checkDiagsHasError(t, resp.Diagnostics)
-}
-
-func TestGRPCProvider_invokeAction_linked_missing_linked_resources(t *testing.T) {
-	client := mockProviderClient(t)
-	p := &GRPCProvider{
-		client: client,
 	}
 
-	// Missing LinkedResources for a linked action should error
-	resp := p.InvokeAction(providers.InvokeActionRequest{
-		ActionType: "linked",
-		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
+	client.EXPECT().ListResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(mockStream, nil)
+
+	// Create the request
+	configVal := cty.ObjectVal(map[string]cty.Value{
+		"config": cty.ObjectVal(map[string]cty.Value{
+			"filter_attr": cty.StringVal("filter-value"),
 		}),
 	})
+	request := providers.ListResourceRequest{
+		TypeName:              "list",
+		Config:                configVal,
+		IncludeResourceObject: true,
+		Limit:                 100,
+	}
 
-	checkDiagsHasError(t, resp.Diagnostics)
-}
+	resp := p.ListResource(request)
+	checkDiags(t, resp.Diagnostics)
 
-func TestGRPCProvider_invokeAction_linked_too_few_linked_resources(t *testing.T) {
-	client := mockProviderClient(t)
-	p := &GRPCProvider{
-		client: client,
+	data := resp.Result.AsValueMap()
+	if _, ok := data["data"]; !ok {
+		t.Fatal("Expected 'data' key in result")
+	}
+	// Verify that we received both events
+	if len(data["data"].AsValueSlice()) != 2 {
+		t.Fatalf("Expected 2 resources, got %d", len(data["data"].AsValueSlice()))
 	}
+	results := data["data"].AsValueSlice()
 
-	// Only one LinkedResource when two are required
-	resp := p.InvokeAction(providers.InvokeActionRequest{
-		ActionType: "linked",
-		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
-		}),
-		LinkedResources: []providers.LinkedResourceInvokeData{
-			{
-				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
-				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
-				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
-				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
-			},
-		},
+	// Verify first event
+	displayName := results[0].GetAttr("display_name")
+	if displayName.AsString() != "Test Resource 1" {
+		t.Errorf("Expected DisplayName 'Test Resource 1', got '%s'", displayName.AsString())
+	}
+
+	expectedId1 := cty.ObjectVal(map[string]cty.Value{
+		"id_attr": cty.StringVal("id-1"),
 	})
 
-	checkDiagsHasError(t, resp.Diagnostics)
-}
+	identity := results[0].GetAttr("identity")
+	if !identity.RawEquals(expectedId1) {
+		t.Errorf("Expected Identity %#v, got %#v", expectedId1, identity)
+	}
 
-func TestGRPCProvider_invokeAction_linked_too_many_linked_resources(t *testing.T) {
-	client := mockProviderClient(t)
-	p := &GRPCProvider{
-		client: client,
+	// ResourceObject should be null for the first event as it wasn't provided
+	resourceObject := results[0].GetAttr("state")
+	if !resourceObject.IsNull() {
+		t.Errorf("Expected ResourceObject to be null, got %#v", resourceObject)
 	}
 
-	// Three LinkedResources when two are required
-	resp := p.InvokeAction(providers.InvokeActionRequest{
-		ActionType: "linked",
-		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
-		}),
-		LinkedResources: []providers.LinkedResourceInvokeData{
-			{
-				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
-				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
-				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
-				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
-			},
-			{
-				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
-				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
-				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
-				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
-			},
-			{
-				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old3")}),
-				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new3")}),
-				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg3")}),
-				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id3")}),
-			},
-		},
+	// Verify second event
+	displayName = results[1].GetAttr("display_name")
+	if displayName.AsString() != "Test Resource 2" {
+		t.Errorf("Expected DisplayName 'Test Resource 2', got '%s'", displayName.AsString())
+	}
+
+	expectedId2 := cty.ObjectVal(map[string]cty.Value{
+		"id_attr": cty.StringVal("id-2"),
 	})
+	identity = results[1].GetAttr("identity")
+	if !identity.RawEquals(expectedId2) {
+		t.Errorf("Expected Identity %#v, got %#v", expectedId2, identity)
+	}
 
-	checkDiagsHasError(t, resp.Diagnostics)
+	expectedResource := cty.ObjectVal(map[string]cty.Value{
+		"resource_attr": cty.StringVal("value"),
+	})
+	resourceObject = results[1].GetAttr("state")
+	if !resourceObject.RawEquals(expectedResource) {
+		t.Errorf("Expected ResourceObject %#v, got %#v", expectedResource, resourceObject)
+	}
 }
 
-func TestGRPCProvider_invokeAction_linked_provider_returns_error(t *testing.T) {
-	ctrl := gomock.NewController(t)
+// TestGRPCProvider_ListResource_WrappedEOF ensures that a gRPC stream wrapper
+// returning an error that wraps io.EOF, rather than io.EOF itself, is still
+// treated as a clean end of stream rather than an error.
+func TestGRPCProvider_ListResource_WrappedEOF(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
+		ctx:    context.Background(),
 	}
 
-	mockInvokeClient := mockproto.NewMockProvider_InvokeActionClient(ctrl)
-	mockInvokeClient.EXPECT().Recv().Return(&proto.InvokeAction_Event{
-		Type: &proto.InvokeAction_Event_Completed_{
-			Completed: &proto.InvokeAction_Event_Completed{
-				Diagnostics: []*proto.Diagnostic{
-					{
-						Severity: proto.Diagnostic_ERROR,
-						Summary:  "Provider error",
-						Detail:   "Something went wrong",
+	mockStream := &wrappedEOFListResourceStreamClient{
+		events: []*proto.ListResource_Event{
+			{
+				DisplayName: "Test Resource 1",
+				Identity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Msgpack: []byte("\x81\xa7id_attr\xa4id-1"),
 					},
 				},
 			},
 		},
-	}, nil)
-
-	mockInvokeClient.EXPECT().Recv().Return(nil, io.EOF)
+	}
 
-	client.EXPECT().InvokeAction(
+	client.EXPECT().ListResource(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(mockInvokeClient, nil)
+	).Return(mockStream, nil)
 
-	resp := p.InvokeAction(providers.InvokeActionRequest{
-		ActionType: "linked",
-		PlannedActionData: cty.ObjectVal(map[string]cty.Value{
-			"attr": cty.StringVal("foo"),
+	configVal := cty.ObjectVal(map[string]cty.Value{
+		"config": cty.ObjectVal(map[string]cty.Value{
+			"filter_attr": cty.StringVal("filter-value"),
 		}),
-		LinkedResources: []providers.LinkedResourceInvokeData{
-			{
-				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old")}),
-				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new")}),
-				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg")}),
-				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id")}),
-			},
-			{
-				PriorState:      cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("old2")}),
-				PlannedState:    cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("new2")}),
-				Config:          cty.ObjectVal(map[string]cty.Value{"attr": cty.StringVal("cfg2")}),
-				PlannedIdentity: cty.ObjectVal(map[string]cty.Value{"id_attr": cty.StringVal("id2")}),
-			},
-		},
 	})
+	request := providers.ListResourceRequest{
+		TypeName: "list",
+		Config:   configVal,
+		Limit:    100,
+	}
 
-	// The resp.Diagnostics only fail if the call could not be made
+	resp := p.ListResource(request)
 	checkDiags(t, resp.Diagnostics)
 
-	evts := []providers.InvokeActionEvent{}
-	for e := range resp.Events {
-		evts = append(evts, e)
+	data := resp.Result.AsValueMap()
+	if len(data["data"].AsValueSlice()) != 1 {
+		t.Fatalf("Expected 1 resource, got %d", len(data["data"].AsValueSlice()))
 	}
+}
 
-	if len(evts) != 1 {
-		t.Fatalf("expected 1 event, got %d", len(evts))
+// TestGRPCProvider_StreamListResource verifies that the sink is called once
+// per event, in order, and that the summary count matches, without ever
+// materializing the aggregate cty.TupleVal that ListResource builds.
+func TestGRPCProvider_StreamListResource(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+		ctx:    context.Background(),
 	}
 
-	evt, ok := evts[0].(providers.InvokeActionEvent_Completed)
-	if !ok {
-		t.Fatalf("expected completed event, got %T", evts[0])
+	mockStream := &mockListResourceStreamClient{
+		events: []*proto.ListResource_Event{
+			{
+				DisplayName: "Test Resource 1",
+				Identity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Msgpack: []byte("\x81\xa7id_attr\xa4id-1"),
+					},
+				},
+			},
+			{
+				DisplayName: "Test Resource 2",
+				Identity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Msgpack: []byte("\x81\xa7id_attr\xa4id-2"),
+					},
+				},
+			},
+		},
 	}
 
-	checkDiagsHasError(t, evt.Diagnostics)
-}
+	client.EXPECT().ListResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(mockStream, nil)
+
+	configVal := cty.ObjectVal(map[string]cty.Value{
+		"config": cty.ObjectVal(map[string]cty.Value{
+			"filter_attr": cty.StringVal("filter-value"),
+		}),
+	})
 
-// Mock implementation of the ListResource stream client
-type mockListResourceStreamClient struct {
-	events  []*proto.ListResource_Event
-	current int
-	proto.Provider_ListResourceClient
-}
+	var displayNames []string
+	count, diags := p.StreamListResource(providers.ListResourceRequest{
+		TypeName: "list",
+		Config:   configVal,
+		Limit:    100,
+	}, func(v cty.Value) error {
+		displayNames = append(displayNames, v.GetAttr("display_name").AsString())
+		return nil
+	})
+	checkDiags(t, diags)
 
-func (m *mockListResourceStreamClient) Recv() (*proto.ListResource_Event, error) {
-	if m.current >= len(m.events) {
-		return nil, io.EOF
+	if count != 2 {
+		t.Fatalf("expected count 2, got %d", count)
+	}
+	if !cmp.Equal(displayNames, []string{"Test Resource 1", "Test Resource 2"}) {
+		t.Fatalf("unexpected display names: %#v", displayNames)
 	}
-
-	event := m.events[m.current]
-	m.current++
-	return event, nil
 }
 
-func TestGRPCProvider_ListResource(t *testing.T) {
+func TestGRPCProvider_ListResource_Hydrate(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
 		client: client,
 		ctx:    context.Background(),
 	}
 
-	// Create a mock stream client that will return resource events
+	// Neither event carries a resource object: the provider only returned
+	// identities, and it's up to Hydrate to decide which ones are worth a
+	// follow-up read.
 	mockStream := &mockListResourceStreamClient{
 		events: []*proto.ListResource_Event{
 			{
@@ -2627,9 +6954,6 @@ func TestGRPCProvider_ListResource(t *testing.T) {
 						Msgpack: []byte("\x81\xa7id_attr\xa4id-2"),
 					},
 				},
-				ResourceObject: &proto.DynamicValue{
-					Msgpack: []byte("\x81\xadresource_attr\xa5value"),
-				},
 			},
 		},
 	}
@@ -2639,73 +6963,60 @@ func TestGRPCProvider_ListResource(t *testing.T) {
 		gomock.Any(),
 	).Return(mockStream, nil)
 
-	// Create the request
+	// Only id-2 should be hydrated, so we only expect a single follow-up
+	// ReadResource call.
+	client.EXPECT().ReadResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ReadResource_Response{
+		NewState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xadresource_attr\xa5value"),
+		},
+		NewIdentity: &proto.ResourceIdentityData{
+			IdentityData: &proto.DynamicValue{
+				Msgpack: []byte("\x81\xa7id_attr\xa4id-2"),
+			},
+		},
+	}, nil).Times(1)
+
+	var hydrated []string
 	configVal := cty.ObjectVal(map[string]cty.Value{
 		"config": cty.ObjectVal(map[string]cty.Value{
 			"filter_attr": cty.StringVal("filter-value"),
 		}),
 	})
 	request := providers.ListResourceRequest{
-		TypeName:              "list",
-		Config:                configVal,
-		IncludeResourceObject: true,
-		Limit:                 100,
+		TypeName: "list",
+		Config:   configVal,
+		Limit:    100,
+		Hydrate: func(identity cty.Value) bool {
+			id := identity.GetAttr("id_attr").AsString()
+			hydrated = append(hydrated, id)
+			return id == "id-2"
+		},
 	}
 
 	resp := p.ListResource(request)
 	checkDiags(t, resp.Diagnostics)
 
-	data := resp.Result.AsValueMap()
-	if _, ok := data["data"]; !ok {
-		t.Fatal("Expected 'data' key in result")
-	}
-	// Verify that we received both events
-	if len(data["data"].AsValueSlice()) != 2 {
-		t.Fatalf("Expected 2 resources, got %d", len(data["data"].AsValueSlice()))
-	}
-	results := data["data"].AsValueSlice()
-
-	// Verify first event
-	displayName := results[0].GetAttr("display_name")
-	if displayName.AsString() != "Test Resource 1" {
-		t.Errorf("Expected DisplayName 'Test Resource 1', got '%s'", displayName.AsString())
-	}
-
-	expectedId1 := cty.ObjectVal(map[string]cty.Value{
-		"id_attr": cty.StringVal("id-1"),
-	})
-
-	identity := results[0].GetAttr("identity")
-	if !identity.RawEquals(expectedId1) {
-		t.Errorf("Expected Identity %#v, got %#v", expectedId1, identity)
-	}
-
-	// ResourceObject should be null for the first event as it wasn't provided
-	resourceObject := results[0].GetAttr("state")
-	if !resourceObject.IsNull() {
-		t.Errorf("Expected ResourceObject to be null, got %#v", resourceObject)
+	if !reflect.DeepEqual(hydrated, []string{"id-1", "id-2"}) {
+		t.Fatalf("expected Hydrate to be consulted for both events, got %v", hydrated)
 	}
 
-	// Verify second event
-	displayName = results[1].GetAttr("display_name")
-	if displayName.AsString() != "Test Resource 2" {
-		t.Errorf("Expected DisplayName 'Test Resource 2', got '%s'", displayName.AsString())
+	results := resp.Result.GetAttr("data").AsValueSlice()
+	if len(results) != 2 {
+		t.Fatalf("expected 2 resources, got %d", len(results))
 	}
 
-	expectedId2 := cty.ObjectVal(map[string]cty.Value{
-		"id_attr": cty.StringVal("id-2"),
-	})
-	identity = results[1].GetAttr("identity")
-	if !identity.RawEquals(expectedId2) {
-		t.Errorf("Expected Identity %#v, got %#v", expectedId2, identity)
+	if !results[0].GetAttr("state").IsNull() {
+		t.Errorf("expected state for id-1 to remain null, got %#v", results[0].GetAttr("state"))
 	}
 
 	expectedResource := cty.ObjectVal(map[string]cty.Value{
 		"resource_attr": cty.StringVal("value"),
 	})
-	resourceObject = results[1].GetAttr("state")
-	if !resourceObject.RawEquals(expectedResource) {
-		t.Errorf("Expected ResourceObject %#v, got %#v", expectedResource, resourceObject)
+	if !results[1].GetAttr("state").RawEquals(expectedResource) {
+		t.Errorf("expected state for id-2 to be hydrated, got %#v", results[1].GetAttr("state"))
 	}
 }
 
@@ -2794,6 +7105,96 @@ func TestGRPCProvider_ListResource_Diagnostics(t *testing.T) {
 	}
 }
 
+func TestGRPCProvider_ListResource_MissingIdentity(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+		ctx:    context.Background(),
+	}
+
+	mockStream := &mockListResourceStreamClient{
+		events: []*proto.ListResource_Event{
+			{
+				DisplayName: "Test Resource 1",
+			},
+		},
+	}
+
+	client.EXPECT().ListResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(mockStream, nil)
+
+	configVal := cty.ObjectVal(map[string]cty.Value{
+		"config": cty.ObjectVal(map[string]cty.Value{
+			"filter_attr": cty.StringVal("filter-value"),
+		}),
+	})
+	request := providers.ListResourceRequest{
+		TypeName: "list",
+		Config:   configVal,
+		Limit:    100,
+	}
+
+	resp := p.ListResource(request)
+	checkDiagsHasError(t, resp.Diagnostics)
+}
+
+func TestGRPCProvider_ListResource_AllowMissingIdentity(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+		ctx:    context.Background(),
+	}
+
+	mockStream := &mockListResourceStreamClient{
+		events: []*proto.ListResource_Event{
+			{
+				DisplayName: "Test Resource 1",
+			},
+			{
+				DisplayName: "Test Resource 2",
+				Identity: &proto.ResourceIdentityData{
+					IdentityData: &proto.DynamicValue{
+						Msgpack: []byte("\x81\xa7id_attr\xa4id-2"),
+					},
+				},
+			},
+		},
+	}
+
+	client.EXPECT().ListResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(mockStream, nil)
+
+	configVal := cty.ObjectVal(map[string]cty.Value{
+		"config": cty.ObjectVal(map[string]cty.Value{
+			"filter_attr": cty.StringVal("filter-value"),
+		}),
+	})
+	request := providers.ListResourceRequest{
+		TypeName:             "list",
+		Config:               configVal,
+		Limit:                100,
+		AllowMissingIdentity: true,
+	}
+
+	resp := p.ListResource(request)
+	checkDiags(t, resp.Diagnostics)
+	if !resp.Diagnostics.HasWarnings() {
+		t.Fatal("expected a warning for the event with no identity data")
+	}
+
+	results := resp.Result.GetAttr("data").AsValueSlice()
+	if len(results) != 2 {
+		t.Fatalf("expected both events to be included in the result, got %d", len(results))
+	}
+	if !results[0].GetAttr("identity").IsNull() {
+		t.Errorf("expected identity for the first event to be null, got %#v", results[0].GetAttr("identity"))
+	}
+}
+
 func TestGRPCProvider_ListResource_Limit(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
@@ -2866,3 +7267,133 @@ func TestGRPCProvider_ListResource_Limit(t *testing.T) {
 		t.Fatalf("Expected 2 events, got %d", len(results))
 	}
 }
+
+func TestGRPCProvider_CountListResource(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+		ctx:    context.Background(),
+	}
+
+	// Create a mock stream client that will return resource events. None of
+	// them carry a resource object, since CountListResource never asks for one.
+	mockStream := &mockListResourceStreamClient{
+		events: []*proto.ListResource_Event{
+			{DisplayName: "Test Resource 1"},
+			{DisplayName: "Test Resource 2"},
+			{DisplayName: "Test Resource 3"},
+		},
+	}
+
+	client.EXPECT().ListResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(mockStream, nil)
+
+	configVal := cty.ObjectVal(map[string]cty.Value{
+		"config": cty.ObjectVal(map[string]cty.Value{
+			"filter_attr": cty.StringVal("filter-value"),
+		}),
+	})
+	request := providers.ListResourceRequest{
+		TypeName: "list",
+		Config:   configVal,
+		Limit:    100,
+	}
+
+	count, diags := p.CountListResource(request)
+	checkDiags(t, diags)
+
+	if count != int64(len(mockStream.events)) {
+		t.Fatalf("expected count to match the number of streamed events (%d), got %d", len(mockStream.events), count)
+	}
+}
+
+func TestGRPCProvider_CountListResource_Limit(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+		ctx:    context.Background(),
+	}
+
+	mockStream := &mockListResourceStreamClient{
+		events: []*proto.ListResource_Event{
+			{DisplayName: "Test Resource 1"},
+			{DisplayName: "Test Resource 2"},
+			{DisplayName: "Test Resource 3"},
+		},
+	}
+
+	client.EXPECT().ListResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(mockStream, nil)
+
+	configVal := cty.ObjectVal(map[string]cty.Value{
+		"config": cty.ObjectVal(map[string]cty.Value{
+			"filter_attr": cty.StringVal("filter-value"),
+		}),
+	})
+	request := providers.ListResourceRequest{
+		TypeName: "list",
+		Config:   configVal,
+		Limit:    2,
+	}
+
+	count, diags := p.CountListResource(request)
+	checkDiags(t, diags)
+
+	if count != 2 {
+		t.Fatalf("expected count to be capped at the requested limit (2), got %d", count)
+	}
+}
+
+func TestGRPCProvider_checkConformance_conforming(t *testing.T) {
+	p := &GRPCProvider{
+		StrictConformance: true,
+	}
+
+	v := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+	ty := cty.Object(map[string]cty.Type{
+		"attr": cty.String,
+	})
+
+	diags := p.checkConformance("new state for test_resource", v, ty)
+	checkDiags(t, diags)
+}
+
+func TestGRPCProvider_checkConformance_nonConforming(t *testing.T) {
+	p := &GRPCProvider{
+		StrictConformance: true,
+	}
+
+	v := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.NumberIntVal(1),
+	})
+	ty := cty.Object(map[string]cty.Type{
+		"attr": cty.String,
+	})
+
+	diags := p.checkConformance("new state for test_resource", v, ty)
+	checkDiagsHasError(t, diags)
+	got := diags.Err().Error()
+	if !strings.Contains(got, "new state for test_resource") {
+		t.Fatalf("expected diagnostic to mention the label, got: %s", got)
+	}
+}
+
+func TestGRPCProvider_checkConformance_disabledByDefault(t *testing.T) {
+	p := &GRPCProvider{}
+
+	v := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.NumberIntVal(1),
+	})
+	ty := cty.Object(map[string]cty.Type{
+		"attr": cty.String,
+	})
+
+	diags := p.checkConformance("new state for test_resource", v, ty)
+	checkDiags(t, diags)
+}
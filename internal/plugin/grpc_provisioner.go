@@ -63,7 +63,7 @@ func (p *GRPCProvisioner) GetSchema() (resp provisioners.GetSchemaResponse) {
 
 	protoResp, err := p.client.GetSchema(p.ctx, new(proto.GetProvisionerSchema_Request))
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(p.ctx, err))
 		return resp
 	}
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
@@ -98,7 +98,7 @@ func (p *GRPCProvisioner) ValidateProvisionerConfig(r provisioners.ValidateProvi
 	}
 	protoResp, err := p.client.ValidateProvisionerConfig(p.ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(p.ctx, err))
 		return resp
 	}
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
@@ -132,7 +132,7 @@ func (p *GRPCProvisioner) ProvisionResource(r provisioners.ProvisionResourceRequ
 
 	outputClient, err := p.client.ProvisionResource(p.ctx, protoReq)
 	if err != nil {
-		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(p.ctx, err))
 		return resp
 	}
 
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"encoding/json"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// SchemaJSON fetches this provider's schema and serializes it to a stable
+// JSON representation, for tooling such as `terraform providers schema
+// -json` and for golden-file testing of schema conversion. Map keys are
+// sorted alphabetically by encoding/json, so the output is deterministic
+// across calls.
+func (p *GRPCProvider) SchemaJSON() ([]byte, tfdiags.Diagnostics) {
+	schema := p.GetProviderSchema()
+	if schema.Diagnostics.HasErrors() {
+		return nil, schema.Diagnostics
+	}
+
+	dump := schemaJSON{
+		Provider:                 schemaVersionToJSON(schema.Provider),
+		ResourceSchemas:          schemaVersionsToJSON(schema.ResourceTypes),
+		DataSourceSchemas:        schemaVersionsToJSON(schema.DataSources),
+		EphemeralResourceSchemas: schemaVersionsToJSON(schema.EphemeralResourceTypes),
+		ListResourceSchemas:      schemaVersionsToJSON(schema.ListResourceTypes),
+		ActionSchemas:            actionSchemasToJSON(schema.Actions),
+		Functions:                functionsToJSON(schema.Functions),
+		ServerCapabilities:       schema.ServerCapabilities,
+	}
+
+	ret, err := json.Marshal(dump)
+	if err != nil {
+		return nil, schema.Diagnostics.Append(err)
+	}
+	return ret, schema.Diagnostics
+}
+
+type schemaJSON struct {
+	Provider                 *schemaVersionJSON            `json:"provider,omitempty"`
+	ResourceSchemas          map[string]*schemaVersionJSON `json:"resource_schemas,omitempty"`
+	DataSourceSchemas        map[string]*schemaVersionJSON `json:"data_source_schemas,omitempty"`
+	EphemeralResourceSchemas map[string]*schemaVersionJSON `json:"ephemeral_resource_schemas,omitempty"`
+	ListResourceSchemas      map[string]*schemaVersionJSON `json:"list_resource_schemas,omitempty"`
+	ActionSchemas            map[string]*actionJSON        `json:"action_schemas,omitempty"`
+	Functions                map[string]*functionJSON      `json:"functions,omitempty"`
+	ServerCapabilities       providers.ServerCapabilities  `json:"server_capabilities"`
+}
+
+type schemaVersionJSON struct {
+	Version int64      `json:"version"`
+	Block   *blockJSON `json:"block,omitempty"`
+}
+
+func schemaVersionToJSON(schema providers.Schema) *schemaVersionJSON {
+	if schema.Body == nil {
+		return nil
+	}
+	return &schemaVersionJSON{
+		Version: schema.Version,
+		Block:   blockToJSON(schema.Body),
+	}
+}
+
+func schemaVersionsToJSON(schemas map[string]providers.Schema) map[string]*schemaVersionJSON {
+	if len(schemas) == 0 {
+		return nil
+	}
+	ret := make(map[string]*schemaVersionJSON, len(schemas))
+	for name, schema := range schemas {
+		ret[name] = schemaVersionToJSON(schema)
+	}
+	return ret
+}
+
+type blockJSON struct {
+	Attributes  map[string]*attributeJSON   `json:"attributes,omitempty"`
+	BlockTypes  map[string]*nestedBlockJSON `json:"block_types,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Deprecated  bool                        `json:"deprecated,omitempty"`
+}
+
+func blockToJSON(block *configschema.Block) *blockJSON {
+	if block == nil {
+		return nil
+	}
+
+	ret := &blockJSON{
+		Description: block.Description,
+		Deprecated:  block.Deprecated,
+	}
+
+	if len(block.Attributes) > 0 {
+		ret.Attributes = make(map[string]*attributeJSON, len(block.Attributes))
+		for name, attr := range block.Attributes {
+			ret.Attributes[name] = attributeToJSON(attr)
+		}
+	}
+
+	if len(block.BlockTypes) > 0 {
+		ret.BlockTypes = make(map[string]*nestedBlockJSON, len(block.BlockTypes))
+		for name, nested := range block.BlockTypes {
+			ret.BlockTypes[name] = &nestedBlockJSON{
+				Block:    blockToJSON(&nested.Block),
+				Nesting:  nested.Nesting.String(),
+				MinItems: nested.MinItems,
+				MaxItems: nested.MaxItems,
+			}
+		}
+	}
+
+	return ret
+}
+
+type nestedBlockJSON struct {
+	Block    *blockJSON `json:"block,omitempty"`
+	Nesting  string     `json:"nesting_mode,omitempty"`
+	MinItems int        `json:"min_items,omitempty"`
+	MaxItems int        `json:"max_items,omitempty"`
+}
+
+type attributeJSON struct {
+	Type        json.RawMessage `json:"type,omitempty"`
+	NestedType  *objectJSON     `json:"nested_type,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Required    bool            `json:"required,omitempty"`
+	Optional    bool            `json:"optional,omitempty"`
+	Computed    bool            `json:"computed,omitempty"`
+	Sensitive   bool            `json:"sensitive,omitempty"`
+	Deprecated  bool            `json:"deprecated,omitempty"`
+	WriteOnly   bool            `json:"write_only,omitempty"`
+}
+
+func attributeToJSON(attr *configschema.Attribute) *attributeJSON {
+	ret := &attributeJSON{
+		Description: attr.Description,
+		Required:    attr.Required,
+		Optional:    attr.Optional,
+		Computed:    attr.Computed,
+		Sensitive:   attr.Sensitive,
+		Deprecated:  attr.Deprecated,
+		WriteOnly:   attr.WriteOnly,
+	}
+
+	if attr.Type != cty.NilType {
+		ty, _ := attr.Type.MarshalJSON()
+		ret.Type = ty
+	}
+
+	if attr.NestedType != nil {
+		ret.NestedType = objectToJSON(attr.NestedType)
+	}
+
+	return ret
+}
+
+type objectJSON struct {
+	Attributes map[string]*attributeJSON `json:"attributes,omitempty"`
+	Nesting    string                    `json:"nesting_mode,omitempty"`
+}
+
+func objectToJSON(obj *configschema.Object) *objectJSON {
+	ret := &objectJSON{
+		Nesting: obj.Nesting.String(),
+	}
+	if len(obj.Attributes) > 0 {
+		ret.Attributes = make(map[string]*attributeJSON, len(obj.Attributes))
+		for name, attr := range obj.Attributes {
+			ret.Attributes[name] = attributeToJSON(attr)
+		}
+	}
+	return ret
+}
+
+type actionJSON struct {
+	ConfigSchema    *blockJSON `json:"config_schema,omitempty"`
+	Type            string     `json:"type"`
+	Executes        string     `json:"executes,omitempty"`
+	LinkedResources []string   `json:"linked_resources,omitempty"`
+}
+
+func actionSchemasToJSON(actions map[string]providers.ActionSchema) map[string]*actionJSON {
+	if len(actions) == 0 {
+		return nil
+	}
+
+	ret := make(map[string]*actionJSON, len(actions))
+	for name, action := range actions {
+		a := &actionJSON{
+			ConfigSchema: blockToJSON(action.ConfigSchema),
+		}
+
+		switch {
+		case action.Unlinked != nil:
+			a.Type = "unlinked"
+		case action.Lifecycle != nil:
+			a.Type = "lifecycle"
+			a.Executes = executionOrderToJSON(action.Lifecycle.Executes)
+		case action.Linked != nil:
+			a.Type = "linked"
+		}
+
+		for _, lr := range action.LinkedResources() {
+			a.LinkedResources = append(a.LinkedResources, lr.TypeName)
+		}
+
+		ret[name] = a
+	}
+	return ret
+}
+
+func executionOrderToJSON(order providers.ExecutionOrder) string {
+	switch order {
+	case providers.ExecutionOrderBefore:
+		return "before"
+	case providers.ExecutionOrderAfter:
+		return "after"
+	default:
+		return ""
+	}
+}
+
+type functionJSON struct {
+	Parameters         []functionParamJSON `json:"parameters,omitempty"`
+	VariadicParameter  *functionParamJSON  `json:"variadic_parameter,omitempty"`
+	ReturnType         json.RawMessage     `json:"return_type,omitempty"`
+	Description        string              `json:"description,omitempty"`
+	Summary            string              `json:"summary,omitempty"`
+	DeprecationMessage string              `json:"deprecation_message,omitempty"`
+}
+
+type functionParamJSON struct {
+	Name               string          `json:"name,omitempty"`
+	Type               json.RawMessage `json:"type,omitempty"`
+	AllowNullValue     bool            `json:"allow_null_value,omitempty"`
+	AllowUnknownValues bool            `json:"allow_unknown_values,omitempty"`
+	Description        string          `json:"description,omitempty"`
+}
+
+func functionParamToJSON(param providers.FunctionParam) functionParamJSON {
+	ret := functionParamJSON{
+		Name:               param.Name,
+		AllowNullValue:     param.AllowNullValue,
+		AllowUnknownValues: param.AllowUnknownValues,
+		Description:        param.Description,
+	}
+	if param.Type != cty.NilType {
+		ty, _ := param.Type.MarshalJSON()
+		ret.Type = ty
+	}
+	return ret
+}
+
+func functionsToJSON(fns map[string]providers.FunctionDecl) map[string]*functionJSON {
+	if len(fns) == 0 {
+		return nil
+	}
+
+	ret := make(map[string]*functionJSON, len(fns))
+	for name, fn := range fns {
+		f := &functionJSON{
+			Description:        fn.Description,
+			Summary:            fn.Summary,
+			DeprecationMessage: fn.DeprecationMessage,
+		}
+
+		if fn.ReturnType != cty.NilType {
+			ty, _ := fn.ReturnType.MarshalJSON()
+			f.ReturnType = ty
+		}
+
+		for _, param := range fn.Parameters {
+			f.Parameters = append(f.Parameters, functionParamToJSON(param))
+		}
+
+		if fn.VariadicParameter != nil {
+			variadic := functionParamToJSON(*fn.VariadicParameter)
+			f.VariadicParameter = &variadic
+		}
+
+		ret[name] = f
+	}
+	return ret
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	mockproto "github.com/hashicorp/terraform/internal/plugin/mock_proto"
+	proto "github.com/hashicorp/terraform/internal/tfplugin5"
+)
+
+func TestGRPCProvider_SchemaJSON(t *testing.T) {
+	client := mockproto.NewMockProviderClient(gomock.NewController(t))
+	client.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(&proto.GetProviderSchema_Response{
+		Provider: &proto.Schema{Block: &proto.Schema_Block{}},
+		ResourceSchemas: map[string]*proto.Schema{
+			"test_resource": {
+				Version: 1,
+				Block: &proto.Schema_Block{
+					Attributes: []*proto.Schema_Attribute{
+						{Name: "attr", Type: []byte(`"string"`), Required: true},
+					},
+				},
+			},
+		},
+		ActionSchemas: map[string]*proto.ActionSchema{
+			"test_action": {
+				Schema: &proto.Schema{Block: &proto.Schema_Block{}},
+				Type:   &proto.ActionSchema_Unlinked_{},
+			},
+		},
+		Functions: map[string]*proto.Function{
+			"test_function": {
+				Parameters: []*proto.Function_Parameter{
+					{Name: "input", Type: []byte(`"string"`)},
+				},
+				Return: &proto.Function_Return{Type: []byte(`"string"`)},
+			},
+		},
+		ServerCapabilities: &proto.ServerCapabilities{
+			GetProviderSchemaOptional: true,
+		},
+	}, nil)
+	client.EXPECT().GetResourceIdentitySchemas(gomock.Any(), gomock.Any(), gomock.Any()).Return(&proto.GetResourceIdentitySchemas_Response{}, nil)
+
+	p := &GRPCProvider{client: client}
+
+	got, diags := p.SchemaJSON()
+	checkDiags(t, diags)
+
+	// A small, fully-specified fixture: one resource, one unlinked action,
+	// one function, and a single enabled server capability. Map keys are
+	// sorted alphabetically by encoding/json, so this string is exactly what
+	// SchemaJSON produces every time, not just what it happened to produce
+	// this run.
+	want := `{` +
+		`"provider":{"version":0,"block":{}},` +
+		`"resource_schemas":{"test_resource":{"version":1,"block":{"attributes":{"attr":{"type":"string","required":true}}}}},` +
+		`"action_schemas":{"test_action":{"config_schema":{},"type":"unlinked"}},` +
+		`"functions":{"test_function":{"parameters":[{"name":"input","type":"string"}],"return_type":"string"}},` +
+		`"server_capabilities":{"PlanDestroy":false,"GetProviderSchemaOptional":true,"MoveResourceState":false,"SkipValidateResourceConfig":false}` +
+		`}`
+
+	if string(got) != want {
+		t.Fatalf("schema JSON mismatch\ngot:  %s\nwant: %s", got, want)
+	}
+}
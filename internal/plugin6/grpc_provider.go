@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 	"sync"
 
 	plugin "github.com/hashicorp/go-plugin"
@@ -213,6 +215,10 @@ func (p *GRPCProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
 		resp.ServerCapabilities.MoveResourceState = protoResp.ServerCapabilities.MoveResourceState
 	}
 
+	// GetProviderSchema.Response has no field for a self-reported provider
+	// status, so resp.ProviderStatus stays nil until the protocol grows one;
+	// only in-process (non-wire) provider implementations can populate it.
+
 	// set the global cache if we can
 	if !p.Addr.IsZero() {
 		providers.SchemaCache.Set(p.Addr, resp)
@@ -568,6 +574,7 @@ func (p *GRPCProvider) ReadResource(r providers.ReadResourceRequest) (resp provi
 		CurrentState:       &proto6.DynamicValue{Msgpack: mp},
 		Private:            r.Private,
 		ClientCapabilities: clientCapabilitiesToProto(r.ClientCapabilities),
+		RefreshOnly:        r.RefreshOnly,
 	}
 
 	if metaSchema.Body != nil {
@@ -614,6 +621,7 @@ func (p *GRPCProvider) ReadResource(r providers.ReadResourceRequest) (resp provi
 
 		if resSchema.Identity == nil {
 			resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unknown identity type %q", r.TypeName))
+			return resp
 		}
 
 		resp.Identity, err = decodeDynamicValue(protoResp.NewIdentity.IdentityData, resSchema.Identity.ImpliedType())
@@ -1170,6 +1178,10 @@ func (p *GRPCProvider) RenewEphemeralResource(r providers.RenewEphemeralResource
 
 	resp.Private = protoResp.Private
 
+	// Unlike OpenEphemeralResource.Response, RenewEphemeralResource.Response
+	// has no deferred field on the wire, so resp.Deferred stays nil until
+	// the protocol grows one.
+
 	return resp
 }
 
@@ -1523,51 +1535,60 @@ func (p *GRPCProvider) InvokeAction(r providers.InvokeActionRequest) (resp provi
 		LinkedResources: linkedResources,
 	}
 
-	protoClient, err := p.client.InvokeAction(p.ctx, protoReq)
+	ctx, cancel := context.WithCancel(p.ctx)
+	protoClient, err := p.client.InvokeAction(ctx, protoReq)
 	if err != nil {
+		cancel()
 		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
 		return resp
 	}
 
-	resp.Events = func(yield func(providers.InvokeActionEvent) bool) {
-		logger.Trace("GRPCProvider: InvokeAction: streaming events")
-
-		for {
-			event, err := protoClient.Recv()
-			if err == io.EOF {
-				logger.Trace("GRPCProvider: InvokeAction: end of stream")
-				break
-			}
-			if err != nil {
-				// We handle this by returning a finished response with the error
-				// If the client errors we won't be receiving any more events.
-				yield(providers.InvokeActionEvent_Completed{
-					Diagnostics: grpcErr(err),
-				})
-				break
-			}
-
-			switch ev := event.Type.(type) {
-			case *proto6.InvokeAction_Event_Progress_:
-				yield(providers.InvokeActionEvent_Progress{
-					Message: ev.Progress.Message,
-				})
+	resp.Events = providers.InvokeActionEvents{
+		Seq: func(yield func(providers.InvokeActionEvent) bool) {
+			logger.Trace("GRPCProvider: InvokeAction: streaming events")
+			defer cancel()
 
-			case *proto6.InvokeAction_Event_Completed_:
-				diags := convert.ProtoToDiagnostics(ev.Completed.Diagnostics)
-				linkedResources, err := protoToLinkedResourceResults(schema, actionSchema.LinkedResources(), ev.Completed.LinkedResources)
+			for {
+				event, err := protoClient.Recv()
+				if err == io.EOF {
+					logger.Trace("GRPCProvider: InvokeAction: end of stream")
+					break
+				}
 				if err != nil {
-					diags = diags.Append(grpcErr(err))
+					// We handle this by returning a finished response with the error
+					// If the client errors we won't be receiving any more events.
+					yield(providers.InvokeActionEvent_Completed{
+						Diagnostics: grpcErr(err),
+					})
+					break
 				}
-				yield(providers.InvokeActionEvent_Completed{
-					LinkedResources: linkedResources,
-					Diagnostics:     diags,
-				})
 
-			default:
-				panic(fmt.Sprintf("unexpected event type %T in InvokeAction response", event.Type))
+				switch ev := event.Type.(type) {
+				case *proto6.InvokeAction_Event_Progress_:
+					if !yield(providers.InvokeActionEvent_Progress{
+						Message:  ev.Progress.Message,
+						Fraction: -1,
+					}) {
+						return
+					}
+
+				case *proto6.InvokeAction_Event_Completed_:
+					diags := convert.ProtoToDiagnostics(ev.Completed.Diagnostics)
+					linkedResources, err := protoToLinkedResourceResults(schema, actionSchema.LinkedResources(), ev.Completed.LinkedResources)
+					if err != nil {
+						diags = diags.Append(grpcErr(err))
+					}
+					yield(providers.InvokeActionEvent_Completed{
+						LinkedResources: linkedResources,
+						Diagnostics:     diags,
+					})
+
+				default:
+					panic(fmt.Sprintf("unexpected event type %T in InvokeAction response", event.Type))
+				}
 			}
-		}
+		},
+		Cancel: cancel,
 	}
 
 	return resp
@@ -1750,14 +1771,14 @@ func linkedResourceInvokeDataToProto(schema providers.GetProviderSchemaResponse,
 }
 
 func protoToLinkedResourcePlans(schema providers.GetProviderSchemaResponse, linkedResourceSchema []providers.LinkedResourceSchema, lrs []*proto6.PlanAction_Response_LinkedResource) ([]providers.LinkedResourcePlan, error) {
-
-	linkedResources := make([]providers.LinkedResourcePlan, 0, len(lrs))
-
+	var countErr error
 	if len(lrs) != len(linkedResourceSchema) {
-		return nil, fmt.Errorf("mismatched number of linked resources: expected %d, got %d", len(linkedResourceSchema), len(lrs))
+		countErr = linkedResourceCountError(linkedResourceSchema, len(lrs))
 	}
 
-	for i, lr := range lrs {
+	matched := min(len(lrs), len(linkedResourceSchema))
+	linkedResources := make([]providers.LinkedResourcePlan, 0, matched)
+	for i, lr := range lrs[:matched] {
 		linkedResourceType := linkedResourceSchema[i].TypeName
 		// Currently we restrict linked resources to be within the same provider,
 		// therefore we can use the schema from the provider to decode the values
@@ -1785,18 +1806,18 @@ func protoToLinkedResourcePlans(schema providers.GetProviderSchemaResponse, link
 		})
 	}
 
-	return linkedResources, nil
+	return linkedResources, countErr
 }
 
 func protoToLinkedResourceResults(schema providers.GetProviderSchemaResponse, linkedResourceSchema []providers.LinkedResourceSchema, lrs []*proto6.InvokeAction_Event_Completed_LinkedResource) ([]providers.LinkedResourceResult, error) {
-
-	linkedResources := make([]providers.LinkedResourceResult, 0, len(lrs))
-
+	var countErr error
 	if len(lrs) != len(linkedResourceSchema) {
-		return nil, fmt.Errorf("mismatched number of linked resources: expected %d, got %d", len(linkedResourceSchema), len(lrs))
+		countErr = linkedResourceCountError(linkedResourceSchema, len(lrs))
 	}
 
-	for i, lr := range lrs {
+	matched := min(len(lrs), len(linkedResourceSchema))
+	linkedResources := make([]providers.LinkedResourceResult, 0, matched)
+	for i, lr := range lrs[:matched] {
 		linkedResourceType := linkedResourceSchema[i].TypeName
 		// Currently we restrict linked resources to be within the same provider,
 		// therefore we can use the schema from the provider to decode the values
@@ -1825,5 +1846,28 @@ func protoToLinkedResourceResults(schema providers.GetProviderSchemaResponse, li
 		})
 	}
 
-	return linkedResources, nil
+	return linkedResources, countErr
+}
+
+// linkedResourceCountError builds a diagnostic-friendly error describing a
+// mismatch between the number of linked resources a provider actually
+// returned (got) and the number declared by the action's schema. Unlike a
+// bare "expected N got M" message, it enumerates which linked resources are
+// missing or unexpected so the provider author can tell at a glance which
+// index is wrong.
+func linkedResourceCountError(linkedResourceSchema []providers.LinkedResourceSchema, got int) error {
+	want := len(linkedResourceSchema)
+	if got > want {
+		extra := make([]string, 0, got-want)
+		for i := want; i < got; i++ {
+			extra = append(extra, strconv.Itoa(i))
+		}
+		return fmt.Errorf("provider returned %d linked resources, but only %d were declared; unexpected indices: %s", got, want, strings.Join(extra, ", "))
+	}
+
+	missing := make([]string, 0, want-got)
+	for i := got; i < want; i++ {
+		missing = append(missing, fmt.Sprintf("%d (%s)", i, linkedResourceSchema[i].TypeName))
+	}
+	return fmt.Errorf("provider returned %d linked resources, but %d were declared; missing indices: %s", got, want, strings.Join(missing, ", "))
 }
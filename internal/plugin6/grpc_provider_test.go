@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/msgpack"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -115,6 +117,18 @@ func providerProtoSchema() *proto.GetProviderSchema_Response {
 					},
 				},
 			},
+			"no_identity_resource": {
+				Version: 1,
+				Block: &proto.Schema_Block{
+					Attributes: []*proto.Schema_Attribute{
+						{
+							Name:     "attr",
+							Type:     []byte(`"string"`),
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 		DataSourceSchemas: map[string]*proto.Schema{
 			"data": {
@@ -779,6 +793,80 @@ func TestGRPCProvider_ReadResource(t *testing.T) {
 	}
 }
 
+func TestGRPCProvider_ReadResource_identityWithoutSchema(t *testing.T) {
+	client := mockProviderClient(t)
+	p := &GRPCProvider{
+		client: client,
+	}
+
+	// "no_identity_resource" has no identity schema, but the provider
+	// returns identity data for it anyway.
+	client.EXPECT().ReadResource(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&proto.ReadResource_Response{
+		NewState: &proto.DynamicValue{
+			Msgpack: []byte("\x81\xa4attr\xa3bar"),
+		},
+		NewIdentity: &proto.ResourceIdentityData{
+			IdentityData: &proto.DynamicValue{
+				Msgpack: []byte("\x81\xa7id_attr\xa2id"),
+			},
+		},
+	}, nil)
+
+	resp := p.ReadResource(providers.ReadResourceRequest{
+		TypeName: "no_identity_resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+
+	checkDiagsHasError(t, resp.Diagnostics)
+	if !strings.Contains(resp.Diagnostics.Err().Error(), "unknown identity type") {
+		t.Fatalf("expected diagnostics to mention unknown identity type, got %s", resp.Diagnostics.Err())
+	}
+}
+
+func TestGRPCProvider_ReadResource_refreshOnly(t *testing.T) {
+	newState := &proto.DynamicValue{Msgpack: []byte("\x81\xa4attr\xa3bar")}
+	expected := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+
+	for _, refreshOnly := range []bool{false, true} {
+		t.Run(fmt.Sprintf("refreshOnly=%v", refreshOnly), func(t *testing.T) {
+			client := mockProviderClient(t)
+			p := &GRPCProvider{
+				client: client,
+			}
+
+			var gotRefreshOnly bool
+			client.EXPECT().ReadResource(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, req *proto.ReadResource_Request, _ ...grpc.CallOption) (*proto.ReadResource_Response, error) {
+					gotRefreshOnly = req.RefreshOnly
+					return &proto.ReadResource_Response{NewState: newState}, nil
+				})
+
+			resp := p.ReadResource(providers.ReadResourceRequest{
+				TypeName: "resource",
+				PriorState: cty.ObjectVal(map[string]cty.Value{
+					"attr": cty.StringVal("foo"),
+				}),
+				RefreshOnly: refreshOnly,
+			})
+			checkDiags(t, resp.Diagnostics)
+
+			if gotRefreshOnly != refreshOnly {
+				t.Fatalf("expected RefreshOnly=%v on the wire, got %v", refreshOnly, gotRefreshOnly)
+			}
+			if !cmp.Equal(expected, resp.NewState, typeComparer, valueComparer, equateEmpty) {
+				t.Fatal(cmp.Diff(expected, resp.NewState, typeComparer, valueComparer, equateEmpty))
+			}
+		})
+	}
+}
+
 func TestGRPCProvider_ReadResource_deferred(t *testing.T) {
 	client := mockProviderClient(t)
 	p := &GRPCProvider{
@@ -2384,7 +2472,7 @@ func TestGRPCProvider_invokeAction_unlinked_valid(t *testing.T) {
 	})
 
 	evts := []providers.InvokeActionEvent{}
-	for e := range resp.Events {
+	for e := range resp.Events.Seq {
 		evts = append(evts, e)
 	}
 
@@ -2465,7 +2553,7 @@ func TestGRPCProvider_invokeAction_lifecycle_valid(t *testing.T) {
 	})
 
 	evts := []providers.InvokeActionEvent{}
-	for e := range resp.Events {
+	for e := range resp.Events.Seq {
 		evts = append(evts, e)
 	}
 
@@ -2596,7 +2684,7 @@ func TestGRPCProvider_invokeAction_lifecycle_provider_returns_error(t *testing.T
 	checkDiags(t, resp.Diagnostics)
 
 	evts := []providers.InvokeActionEvent{}
-	for e := range resp.Events {
+	for e := range resp.Events.Seq {
 		evts = append(evts, e)
 	}
 
@@ -2684,7 +2772,7 @@ func TestGRPCProvider_invokeAction_linked_valid(t *testing.T) {
 	})
 
 	evts := []providers.InvokeActionEvent{}
-	for e := range resp.Events {
+	for e := range resp.Events.Seq {
 		evts = append(evts, e)
 	}
 
@@ -2858,7 +2946,7 @@ func TestGRPCProvider_invokeAction_linked_provider_returns_error(t *testing.T) {
 	checkDiags(t, resp.Diagnostics)
 
 	evts := []providers.InvokeActionEvent{}
-	for e := range resp.Events {
+	for e := range resp.Events.Seq {
 		evts = append(evts, e)
 	}
 
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin6
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// PrewarmSchemas concurrently fetches and caches the schemas for the given
+// providers, populating providers.SchemaCache so that later calls to
+// GetProviderSchema on any of them return immediately. It's meant to be
+// called once at startup, before the serial per-provider GetProviderSchema
+// calls that would otherwise dominate latency for configurations with many
+// providers.
+//
+// concurrency caps how many GetProviderSchema calls are in flight at once;
+// values less than 1 are treated as 1. Diagnostics from providers that
+// failed to return a schema are aggregated together, each naming the
+// provider address that produced it.
+func PrewarmSchemas(providers map[addrs.Provider]*GRPCProvider, concurrency int) tfdiags.Diagnostics {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var diags tfdiags.Diagnostics
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+
+	for addr, p := range providers {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(addr addrs.Provider, p *GRPCProvider) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp := p.GetProviderSchema()
+			if resp.Diagnostics.HasErrors() {
+				mu.Lock()
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					"Failed to prewarm provider schema",
+					"Could not fetch the schema for provider "+addr.String()+": "+resp.Diagnostics.Err().Error(),
+				))
+				mu.Unlock()
+			}
+		}(addr, p)
+	}
+
+	wg.Wait()
+	return diags
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin6
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/providers"
+)
+
+func TestPrewarmSchemas(t *testing.T) {
+	addrA := addrs.NewDefaultProvider("prewarm-a")
+	addrB := addrs.NewDefaultProvider("prewarm-b")
+	addrC := addrs.NewDefaultProvider("prewarm-c")
+
+	providerMap := map[addrs.Provider]*GRPCProvider{
+		addrA: {Addr: addrA, client: mockProviderClient(t), ctx: context.Background()},
+		addrB: {Addr: addrB, client: mockProviderClient(t), ctx: context.Background()},
+		addrC: {Addr: addrC, client: mockProviderClient(t), ctx: context.Background()},
+	}
+
+	diags := PrewarmSchemas(providerMap, 2)
+	checkDiags(t, diags)
+
+	for addr := range providerMap {
+		if _, ok := providers.SchemaCache.Get(addr); !ok {
+			t.Fatalf("expected %s to be cached", addr)
+		}
+	}
+}
@@ -150,6 +150,15 @@ type RenewEphemeralResourceResponse struct {
 	// Terraform Core treats this field as opaque.
 	Private []byte
 
+	// Deferred, if present, signals that the provider doesn't currently have
+	// enough information to renew this ephemeral resource instance, for
+	// example because required input values are still unknown. A caller
+	// should treat this distinctly from a successful renewal: it's not an
+	// error, but it does mean the instance cannot be kept alive and should
+	// stop being renewed, the same way a deferred OpenEphemeralResource call
+	// means the instance was never usable to begin with.
+	Deferred *Deferred
+
 	// Diagnostics describes any problems encountered while renewing the
 	// ephemeral resource instance. If this contains errors then the other
 	// response fields must be assumed invalid.
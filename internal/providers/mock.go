@@ -464,10 +464,13 @@ func (m *Mock) InvokeAction(request InvokeActionRequest) InvokeActionResponse {
 		}
 	}
 	return InvokeActionResponse{
-		Events: func(yield func(InvokeActionEvent) bool) {
-			yield(InvokeActionEvent_Completed{
-				LinkedResources: linkedResources,
-			})
+		Events: InvokeActionEvents{
+			Seq: func(yield func(InvokeActionEvent) bool) {
+				yield(InvokeActionEvent_Completed{
+					LinkedResources: linkedResources,
+				})
+			},
+			Cancel: func() {},
 		},
 		Diagnostics: nil,
 	}
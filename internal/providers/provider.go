@@ -177,8 +177,77 @@ type GetProviderSchemaResponse struct {
 
 	// ServerCapabilities lists optional features supported by the provider.
 	ServerCapabilities ServerCapabilities
+
+	// ProviderStatus, if non-nil, is a self-reported health status the
+	// provider attached to its schema response, for example to flag that
+	// it's running in some limited or degraded mode. It is nil whenever the
+	// provider didn't report a status.
+	ProviderStatus *ProviderStatus
+
+	// MinTerraformVersion is the minimum Terraform CLI version the provider
+	// declared it requires, read from its schema response. It is empty
+	// whenever the provider didn't report one.
+	MinTerraformVersion string
+}
+
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp GetProviderSchemaResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp GetProviderSchemaResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
+// ProviderStatusLevel classifies the severity of a self-reported
+// ProviderStatus.
+type ProviderStatusLevel string
+
+const (
+	// ProviderStatusOK indicates the provider considers itself fully
+	// functional.
+	ProviderStatusOK ProviderStatusLevel = "ok"
+
+	// ProviderStatusDegraded indicates the provider is usable but operating
+	// with reduced functionality, such as a subset of resource types
+	// unavailable.
+	ProviderStatusDegraded ProviderStatusLevel = "degraded"
+
+	// ProviderStatusError indicates the provider does not consider itself
+	// usable at all.
+	ProviderStatusError ProviderStatusLevel = "error"
+)
+
+// ProviderStatus is a provider's self-reported health status, surfaced
+// alongside its schema so that callers such as the CLI can warn users when
+// a provider reports anything other than ProviderStatusOK.
+type ProviderStatus struct {
+	Level   ProviderStatusLevel
+	Message string
 }
 
+// SchemaCategories is a bitmask selecting a subset of the schema categories
+// held in a GetProviderSchemaResponse, for callers that only need part of a
+// provider's schema and would rather not pay to build and carry around the
+// rest of it.
+type SchemaCategories uint8
+
+const (
+	SchemaResources SchemaCategories = 1 << iota
+	SchemaDataSources
+	SchemaEphemeralResources
+	SchemaListResources
+	SchemaStateStores
+	SchemaActions
+	SchemaFunctions
+
+	SchemaAllCategories = SchemaResources | SchemaDataSources | SchemaEphemeralResources |
+		SchemaListResources | SchemaStateStores | SchemaActions | SchemaFunctions
+)
+
 // GetResourceIdentitySchemasResponse is the return type for GetResourceIdentitySchemas,
 // and should only be used when handling a value for that method. The handling of
 // of schemas in any other context should always use ResourceIdentitySchemas, so that
@@ -192,6 +261,18 @@ type GetResourceIdentitySchemasResponse struct {
 	Diagnostics tfdiags.Diagnostics
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp GetResourceIdentitySchemasResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp GetResourceIdentitySchemasResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type IdentitySchema struct {
 	Version int64
 
@@ -280,6 +361,14 @@ type ServerCapabilities struct {
 	// The MoveResourceState capability indicates that this provider supports
 	// the MoveResourceState RPC.
 	MoveResourceState bool
+
+	// SkipValidateResourceConfig indicates that this provider does all of
+	// its resource config validation during planning and considers
+	// ValidateResourceConfig a no-op it would rather skip the round trip
+	// for. The plugin protocol has no wire representation for it, so it is
+	// never populated from a provider's declared capabilities; it is true
+	// only where a caller sets it directly, for example through SetSchema.
+	SkipValidateResourceConfig bool
 }
 
 // ClientCapabilities allows Terraform to publish information regarding
@@ -308,6 +397,18 @@ type ValidateProviderConfigResponse struct {
 	Diagnostics tfdiags.Diagnostics
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp ValidateProviderConfigResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp ValidateProviderConfigResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type ValidateResourceConfigRequest struct {
 	// TypeName is the name of the resource type to validate.
 	TypeName string
@@ -325,6 +426,18 @@ type ValidateResourceConfigResponse struct {
 	Diagnostics tfdiags.Diagnostics
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp ValidateResourceConfigResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp ValidateResourceConfigResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type ValidateDataResourceConfigRequest struct {
 	// TypeName is the name of the data source type to validate.
 	TypeName string
@@ -339,6 +452,18 @@ type ValidateDataResourceConfigResponse struct {
 	Diagnostics tfdiags.Diagnostics
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp ValidateDataResourceConfigResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp ValidateDataResourceConfigResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type ValidateEphemeralResourceConfigRequest struct {
 	// TypeName is the name of the data source type to validate.
 	TypeName string
@@ -353,6 +478,18 @@ type ValidateEphemeralResourceConfigResponse struct {
 	Diagnostics tfdiags.Diagnostics
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp ValidateEphemeralResourceConfigResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp ValidateEphemeralResourceConfigResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type ValidateListResourceConfigRequest struct {
 	// TypeName is the name of the list resource type to validate.
 	TypeName string
@@ -376,6 +513,18 @@ type ValidateListResourceConfigResponse struct {
 	Diagnostics tfdiags.Diagnostics
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp ValidateListResourceConfigResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp ValidateListResourceConfigResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type UpgradeResourceStateRequest struct {
 	// TypeName is the name of the resource type being upgraded
 	TypeName string
@@ -401,6 +550,18 @@ type UpgradeResourceStateResponse struct {
 	Diagnostics tfdiags.Diagnostics
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp UpgradeResourceStateResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp UpgradeResourceStateResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type UpgradeResourceIdentityRequest struct {
 	// TypeName is the name of the resource type being upgraded
 	TypeName string
@@ -421,6 +582,18 @@ type UpgradeResourceIdentityResponse struct {
 	Diagnostics tfdiags.Diagnostics
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp UpgradeResourceIdentityResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp UpgradeResourceIdentityResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type ConfigureProviderRequest struct {
 	// Terraform version is the version string from the running instance of
 	// terraform. Providers can use TerraformVersion to verify compatibility,
@@ -437,6 +610,35 @@ type ConfigureProviderRequest struct {
 type ConfigureProviderResponse struct {
 	// Diagnostics contains any warnings or errors from the method call.
 	Diagnostics tfdiags.Diagnostics
+
+	// ConfiguredMeta surfaces informational key/value pairs the provider
+	// resolved while configuring itself, such as the region or endpoint it
+	// ended up talking to, for callers (the CLI, in particular) that want
+	// to report what a provider actually configured for. It's populated
+	// only when the provider's response carries this information, and is
+	// nil otherwise; callers should not depend on any particular key being
+	// present.
+	ConfiguredMeta map[string]string
+
+	// UnavailableFeatures names features or messages the provider was only
+	// able to partially configure, for callers that want to warn a
+	// practitioner about reduced functionality rather than fail the whole
+	// configure call outright. It's populated only when the provider's
+	// response carries this information, and is nil otherwise; callers
+	// should not depend on any particular entry being present.
+	UnavailableFeatures []string
+}
+
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp ConfigureProviderResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp ConfigureProviderResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
 }
 
 type ReadResourceRequest struct {
@@ -461,6 +663,16 @@ type ReadResourceRequest struct {
 
 	// CurrentIdentity is the current identity data of the resource.
 	CurrentIdentity cty.Value
+
+	// RefreshOnly hints to the provider that this read's result cannot lead
+	// to a planned change, because Terraform is running in refresh-only mode
+	// (terraform plan -refresh-only, or the refresh step before a destroy
+	// plan). Providers that perform expensive sub-resource reads only to
+	// compute a diff can use this to skip that work, since the only thing
+	// that matters here is the state that gets persisted. It's purely an
+	// optimization hint: providers that don't understand it are expected to
+	// behave exactly as if it were unset.
+	RefreshOnly bool
 }
 
 // DeferredReason is a string that describes why a resource was deferred.
@@ -520,6 +732,77 @@ type ReadResourceResponse struct {
 	Identity cty.Value
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp ReadResourceResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp ReadResourceResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
+// ReadResourceByIdentityRequest locates and reads a resource using only its
+// identity, without requiring a prior state. This supports import-by-identity
+// and reconciliation flows where Terraform has an identity on hand (for
+// example from a previous run) but no state for the resource it refers to.
+type ReadResourceByIdentityRequest struct {
+	// TypeName is the name of the resource type being read.
+	TypeName string
+
+	// Identity is the identity data used to locate the remote object.
+	Identity cty.Value
+
+	// Private is an opaque blob that will be stored in state along with the
+	// resource. It is intended only for interpretation by the provider itself.
+	Private []byte
+
+	// ProviderMeta is the configuration for the provider_meta block for the
+	// module and provider this resource belongs to. Its use is defined by
+	// each provider, and it should not be used without coordination with
+	// HashiCorp. It is considered experimental and subject to change.
+	ProviderMeta cty.Value
+
+	// ClientCapabilities contains information about the client's capabilities.
+	ClientCapabilities ClientCapabilities
+}
+
+type ReadResourceByIdentityResponse struct {
+	// NewState contains the current state of the resource, as located from
+	// its identity.
+	NewState cty.Value
+
+	// Identity is the object-typed value representing the identity of the
+	// remote object within Terraform. Providers may return a refined or
+	// completed identity from what was supplied in the request.
+	Identity cty.Value
+
+	// Private is an opaque blob that will be stored in state along with the
+	// resource. It is intended only for interpretation by the provider itself.
+	Private []byte
+
+	// Diagnostics contains any warnings or errors from the method call.
+	Diagnostics tfdiags.Diagnostics
+
+	// Deferred if present signals that the provider was not able to fully
+	// complete this operation and a subsequent run is required.
+	Deferred *Deferred
+}
+
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp ReadResourceByIdentityResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp ReadResourceByIdentityResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type PlanResourceChangeRequest struct {
 	// TypeName is the name of the resource type to plan.
 	TypeName string
@@ -588,6 +871,18 @@ type PlanResourceChangeResponse struct {
 	PlannedIdentity cty.Value
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp PlanResourceChangeResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp PlanResourceChangeResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type ApplyResourceChangeRequest struct {
 	// TypeName is the name of the resource type being applied.
 	TypeName string
@@ -616,6 +911,17 @@ type ApplyResourceChangeRequest struct {
 
 	// PlannedIdentity is the planned identity data of the resource.
 	PlannedIdentity cty.Value
+
+	// ClientCapabilities contains information about the client's capabilities.
+	ClientCapabilities ClientCapabilities
+
+	// IdempotencyKey, when set, is a value that core guarantees to be
+	// stable across retries of the same planned change, so that a provider
+	// which supports it can deduplicate an apply that's retried after a
+	// transient failure (for example, a network error after the remote API
+	// call actually succeeded). Providers that don't understand this field
+	// are expected to behave exactly as if it were unset.
+	IdempotencyKey string
 }
 
 type ApplyResourceChangeResponse struct {
@@ -640,8 +946,64 @@ type ApplyResourceChangeResponse struct {
 
 	// NewIdentity is the new identity data of the resource.
 	NewIdentity cty.Value
+
+	// Notices is a list of lightweight, non-diagnostic informational
+	// messages the provider wants to surface about the apply, for the CLI
+	// to render differently from a diagnostic (for example, "resource will
+	// take ~5 min to become active"). Unlike diagnostics, these never
+	// represent a warning or error and have no effect on whether the apply
+	// succeeded. It's empty if the provider sent none.
+	Notices []string
+}
+
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp ApplyResourceChangeResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp ApplyResourceChangeResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
+type ApplyResourceChangeStreamResponse struct {
+	Events ApplyResourceChangeEvents
+}
+
+// ApplyResourceChangeEvents is the iterator an ApplyResourceChangeStream
+// returns its progress events through, plus the final response. It mirrors
+// InvokeActionEvents: breaking out of a range over Events stops local
+// iteration, and Cancel requests the provider's stream to terminate early
+// from outside the iteration.
+type ApplyResourceChangeEvents struct {
+	iter.Seq[ApplyResourceChangeEvent]
+	Cancel func()
+}
+
+type ApplyResourceChangeEvent interface {
+	isApplyResourceChangeEvent()
 }
 
+// Completed Event
+var _ ApplyResourceChangeEvent = &ApplyResourceChangeEvent_Completed{}
+
+type ApplyResourceChangeEvent_Completed struct {
+	Response ApplyResourceChangeResponse
+}
+
+func (e ApplyResourceChangeEvent_Completed) isApplyResourceChangeEvent() {}
+
+// Progress Event
+var _ ApplyResourceChangeEvent = &ApplyResourceChangeEvent_Progress{}
+
+type ApplyResourceChangeEvent_Progress struct {
+	Message string
+}
+
+func (e ApplyResourceChangeEvent_Progress) isApplyResourceChangeEvent() {}
+
 type ImportResourceStateRequest struct {
 	// TypeName is the name of the resource type to be imported.
 	TypeName string
@@ -672,6 +1034,18 @@ type ImportResourceStateResponse struct {
 	Deferred *Deferred
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp ImportResourceStateResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp ImportResourceStateResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 // ImportedResource represents an object being imported into Terraform with the
 // help of a provider. An ImportedResource is a RemoteObject that has been read
 // by the provider's import handler but hasn't yet been committed to state.
@@ -722,6 +1096,13 @@ type MoveResourceStateRequest struct {
 
 	// SourceIdentity is the identity data of the resource that is being moved.
 	SourceIdentity []byte
+
+	// Validate, when set, indicates that the caller only wants to check
+	// that this move would succeed, without persisting its result. The
+	// wire protocol has no field to carry this signal to the provider, so
+	// the provider still performs the transform as normal; the caller is
+	// responsible for discarding the response instead of applying it.
+	Validate bool
 }
 
 type MoveResourceStateResponse struct {
@@ -738,6 +1119,28 @@ type MoveResourceStateResponse struct {
 
 	// TargetIdentity is the identity data of the resource that is being moved.
 	TargetIdentity cty.Value
+
+	// Deferred, if present, signals that the provider was not able to fully
+	// complete this move, for example because the source or target provider
+	// configuration is not yet known, and the caller should treat the move
+	// as deferred rather than persisting TargetState.
+	//
+	// The wire protocol's MoveResourceState.Response has no deferred field
+	// yet, unlike PlanResourceChange and ReadDataSource, so this is always
+	// nil until tfplugin5.proto/tfplugin6.proto grow one.
+	Deferred *Deferred
+}
+
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp MoveResourceStateResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp MoveResourceStateResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
 }
 
 type ReadDataSourceRequest struct {
@@ -755,6 +1158,22 @@ type ReadDataSourceRequest struct {
 
 	// ClientCapabilities contains information about the client's capabilities.
 	ClientCapabilities ClientCapabilities
+
+	// RetryUntil, if set, is consulted after each read of this data source.
+	// If it returns false, ReadDataSource re-reads the data source again
+	// after a short backoff rather than returning immediately, up to a
+	// bounded number of attempts. This exists for data sources backed by
+	// eventually-consistent cloud APIs that can return stale or empty
+	// results immediately after the referenced resource was created.
+	//
+	// If every attempt's result fails the predicate, ReadDataSource returns
+	// the last result it got along with a warning, rather than an error,
+	// since that result may still be the caller's best available answer.
+	//
+	// Only the protocol 5 client in internal/plugin acts on RetryUntil; other
+	// implementations of Interface, including the protocol 6 client in
+	// internal/plugin6, read the data source once and ignore it.
+	RetryUntil func(cty.Value) bool
 }
 
 type ReadDataSourceResponse struct {
@@ -769,6 +1188,18 @@ type ReadDataSourceResponse struct {
 	Deferred *Deferred
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp ReadDataSourceResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp ReadDataSourceResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type CallFunctionRequest struct {
 	// FunctionName is the local name of the function to call, as it was
 	// declared by the provider in its schema and without any
@@ -809,6 +1240,18 @@ type ListResourceResponse struct {
 	Diagnostics tfdiags.Diagnostics
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp ListResourceResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp ListResourceResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type ListResourceRequest struct {
 	// TypeName is the name of the resource type being read.
 	TypeName string
@@ -822,6 +1265,32 @@ type ListResourceRequest struct {
 
 	// Limit is the maximum number of results to return
 	Limit int64
+
+	// Hydrate, if set, is consulted for each event that arrives with only
+	// identity data, and lets the caller decide per event whether it's
+	// worth the cost of a follow-up ReadResourceByIdentity call to fetch
+	// the full resource object. Providers that support it can return
+	// identities cheaply even for objects that are expensive to fully read,
+	// so a caller that only needs a subset of objects hydrated can avoid
+	// paying for the rest. It has no effect when IncludeResourceObject is
+	// set, since in that case the provider has already included every
+	// object in the stream itself.
+	//
+	// Only the protocol 5 client in internal/plugin consults Hydrate; other
+	// implementations of Interface, including the protocol 6 client in
+	// internal/plugin6, ignore it.
+	Hydrate func(identity cty.Value) bool
+
+	// AllowMissingIdentity controls how an event with no identity data is
+	// handled. By default (false) such an event is an error, which stops
+	// the stream. When true, the event is instead included in the result
+	// with a null identity and a warning, so one malformed event doesn't
+	// break the rest of the list.
+	//
+	// Only the protocol 5 client in internal/plugin reads this field; other
+	// implementations of Interface, including the protocol 6 client in
+	// internal/plugin6, ignore it.
+	AllowMissingIdentity bool
 }
 
 type ValidateStateStoreConfigRequest struct {
@@ -837,6 +1306,18 @@ type ValidateStateStoreConfigResponse struct {
 	Diagnostics tfdiags.Diagnostics
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp ValidateStateStoreConfigResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp ValidateStateStoreConfigResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type ConfigureStateStoreRequest struct {
 	// TypeName is the name of the state store to configure
 	TypeName string
@@ -850,6 +1331,18 @@ type ConfigureStateStoreResponse struct {
 	Diagnostics tfdiags.Diagnostics
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp ConfigureStateStoreResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp ConfigureStateStoreResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type GetStatesRequest struct {
 	// TypeName is the name of the state store to request the list of states from
 	TypeName string
@@ -863,6 +1356,18 @@ type GetStatesResponse struct {
 	Diagnostics tfdiags.Diagnostics
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp GetStatesResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp GetStatesResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type DeleteStateRequest struct {
 	// TypeName is the name of the state store to request deletion from
 	TypeName string
@@ -877,6 +1382,18 @@ type DeleteStateResponse struct {
 	Diagnostics tfdiags.Diagnostics
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp DeleteStateResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp DeleteStateResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type LinkedResourcePlanData struct {
 	PriorState    cty.Value
 	PlannedState  cty.Value
@@ -914,17 +1431,67 @@ type PlanActionResponse struct {
 	Diagnostics     tfdiags.Diagnostics
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp PlanActionResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp PlanActionResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
 type InvokeActionRequest struct {
 	ActionType        string
 	LinkedResources   []LinkedResourceInvokeData
 	PlannedActionData cty.Value
+
+	// BufferSize, when greater than zero, has the response's Events read
+	// from the provider by a background goroutine into a channel of this
+	// capacity, instead of directly by the consumer's iteration. This lets
+	// the provider keep producing events while the consumer is busy
+	// handling one, up to BufferSize events ahead, rather than the two
+	// being in lockstep. A BufferSize of zero (the default) preserves the
+	// original synchronous behavior.
+	//
+	// Only the protocol 5 client in internal/plugin reads BufferSize; other
+	// implementations of Interface, including the protocol 6 client in
+	// internal/plugin6, always read events in lockstep with the consumer.
+	BufferSize int
 }
 
 type InvokeActionResponse struct {
-	Events      iter.Seq[InvokeActionEvent]
+	Events      InvokeActionEvents
 	Diagnostics tfdiags.Diagnostics
 }
 
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp InvokeActionResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp InvokeActionResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
+
+// InvokeActionEvents is the iterator an InvokeActionResponse returns its
+// events through, plus a Cancel func that prompts the provider's stream to
+// terminate early. Breaking out of a range over Events (by returning false
+// from the range body) already stops local iteration and cancels the
+// stream; Cancel exists for a caller that wants to request cancellation
+// without itself driving the iteration, for example from a separate
+// goroutine watching for a user interrupt. It's safe to call multiple
+// times, and safe to call after the stream has already ended.
+type InvokeActionEvents struct {
+	iter.Seq[InvokeActionEvent]
+	Cancel func()
+}
+
 type InvokeActionEvent interface {
 	isInvokeActionEvent()
 }
@@ -944,6 +1511,12 @@ var _ InvokeActionEvent = &InvokeActionEvent_Progress{}
 
 type InvokeActionEvent_Progress struct {
 	Message string
+
+	// Fraction is the provider-reported completion fraction for this action
+	// invocation, in the range [0, 1], or -1 if the provider did not report
+	// one. Callers that want to render a progress bar should treat -1 as
+	// "unknown" rather than "0% complete".
+	Fraction float64
 }
 
 func (e InvokeActionEvent_Progress) isInvokeActionEvent() {}
@@ -973,3 +1546,15 @@ type ValidateActionConfigResponse struct {
 	// Diagnostics contains any warnings or errors from the method call.
 	Diagnostics tfdiags.Diagnostics
 }
+
+// WarningCount returns the number of warning-severity diagnostics in
+// resp.Diagnostics.
+func (resp ValidateActionConfigResponse) WarningCount() int {
+	return resp.Diagnostics.WarningCount()
+}
+
+// ErrorCount returns the number of error-severity diagnostics in
+// resp.Diagnostics.
+func (resp ValidateActionConfigResponse) ErrorCount() int {
+	return resp.Diagnostics.ErrorCount()
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+func TestResponseWarningAndErrorCount(t *testing.T) {
+	var diags tfdiags.Diagnostics
+	diags = diags.Append(tfdiags.Sourceless(tfdiags.Warning, "warning one", ""))
+	diags = diags.Append(tfdiags.Sourceless(tfdiags.Warning, "warning two", ""))
+	diags = diags.Append(tfdiags.Sourceless(tfdiags.Error, "error one", ""))
+
+	resp := ReadResourceResponse{Diagnostics: diags}
+
+	if got, want := resp.WarningCount(), 2; got != want {
+		t.Errorf("wrong WarningCount: got %d, want %d", got, want)
+	}
+	if got, want := resp.ErrorCount(), 1; got != want {
+		t.Errorf("wrong ErrorCount: got %d, want %d", got, want)
+	}
+	if !resp.Diagnostics.HasErrors() || resp.ErrorCount() == 0 {
+		t.Error("ErrorCount should agree with HasErrors")
+	}
+}
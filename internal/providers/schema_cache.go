@@ -13,7 +13,7 @@ import (
 // This will be accessed by both core and the provider clients to ensure that
 // large schemas are stored in a single location.
 var SchemaCache = &schemaCache{
-	m: make(map[addrs.Provider]ProviderSchema),
+	m: make(map[addrs.Provider]*schemaCacheEntry),
 }
 
 // Global cache for provider schemas
@@ -22,20 +22,69 @@ var SchemaCache = &schemaCache{
 // concurrent calls resulting in an error can be handled in the same manner.
 type schemaCache struct {
 	mu sync.Mutex
-	m  map[addrs.Provider]ProviderSchema
+	m  map[addrs.Provider]*schemaCacheEntry
+}
+
+// schemaCacheEntry holds either an already-materialized ProviderSchema, or a
+// load func that produces one lazily the first time it's needed. The latter
+// lets a caller that already has a cheap, uncoverted representation of a
+// schema on hand (for example the raw proto response) defer paying the cost
+// of expanding it into a ProviderSchema's configschema.Block trees until
+// some other part of Terraform actually asks this cache for it.
+type schemaCacheEntry struct {
+	once   sync.Once
+	load   func() ProviderSchema
+	schema ProviderSchema
+}
+
+func (e *schemaCacheEntry) resolve() ProviderSchema {
+	e.once.Do(func() {
+		if e.load != nil {
+			e.schema = e.load()
+			e.load = nil
+		}
+	})
+	return e.schema
 }
 
 func (c *schemaCache) Set(p addrs.Provider, s ProviderSchema) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	c.m[p] = s
+	c.m[p] = &schemaCacheEntry{schema: s}
 }
 
-func (c *schemaCache) Get(p addrs.Provider) (ProviderSchema, bool) {
+// SetLazy registers a provider schema to be produced by load the first time
+// it's requested with Get, rather than expanded up front. Current callers of
+// Set keep the eager behavior; SetLazy is opt-in for callers that want to
+// trade a one-time conversion cost, paid only for providers that are
+// actually consulted, for lower steady-state memory from schemas that are
+// cached but never read back.
+func (c *schemaCache) SetLazy(p addrs.Provider, load func() ProviderSchema) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	s, ok := c.m[p]
-	return s, ok
+	c.m[p] = &schemaCacheEntry{load: load}
+}
+
+// Remove evicts p's cached schema, if any, so that the next Set, SetLazy, or
+// Get-backed fetch for it starts fresh. This supports provider development
+// workflows (such as reattach) where a provider's schema can change between
+// runs without Terraform itself restarting.
+func (c *schemaCache) Remove(p addrs.Provider) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.m, p)
+}
+
+func (c *schemaCache) Get(p addrs.Provider) (ProviderSchema, bool) {
+	c.mu.Lock()
+	entry, ok := c.m[p]
+	c.mu.Unlock()
+	if !ok {
+		return ProviderSchema{}, false
+	}
+
+	return entry.resolve(), true
 }
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+)
+
+func TestSchemaCache_SetLazy(t *testing.T) {
+	c := &schemaCache{m: make(map[addrs.Provider]*schemaCacheEntry)}
+	addr := addrs.NewDefaultProvider("test")
+
+	calls := 0
+	c.SetLazy(addr, func() ProviderSchema {
+		calls++
+		return ProviderSchema{
+			Provider: Schema{Body: &configschema.Block{}},
+		}
+	})
+
+	if calls != 0 {
+		t.Fatalf("expected load not to run until Get, got %d calls", calls)
+	}
+
+	for i := 0; i < 3; i++ {
+		s, ok := c.Get(addr)
+		if !ok {
+			t.Fatal("expected a cached schema")
+		}
+		if s.Provider.Body == nil {
+			t.Fatal("expected the loaded schema to be returned")
+		}
+	}
+
+	if calls != 1 {
+		t.Fatalf("expected load to run exactly once, got %d calls", calls)
+	}
+}
+
+func benchmarkSchema() ProviderSchema {
+	resourceTypes := make(map[string]Schema, 200)
+	for i := 0; i < 200; i++ {
+		attrs := make(map[string]*configschema.Attribute, 20)
+		for j := 0; j < 20; j++ {
+			attrs[string(rune('a'+j))] = &configschema.Attribute{
+				Type:     cty.String,
+				Optional: true,
+			}
+		}
+		resourceTypes[string(rune(i))] = Schema{
+			Body: &configschema.Block{Attributes: attrs},
+		}
+	}
+	return ProviderSchema{ResourceTypes: resourceTypes}
+}
+
+// BenchmarkSchemaCache_Eager reports the allocation cost of populating the
+// cache via Set, which always fully expands the schema.
+func BenchmarkSchemaCache_Eager(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c := &schemaCache{m: make(map[addrs.Provider]*schemaCacheEntry)}
+		c.Set(addrs.NewDefaultProvider("test"), benchmarkSchema())
+	}
+}
+
+// BenchmarkSchemaCache_Lazy reports the allocation cost of populating the
+// cache via SetLazy when the schema is never subsequently read back, which
+// is the common case this option is meant to help with.
+func BenchmarkSchemaCache_Lazy(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c := &schemaCache{m: make(map[addrs.Provider]*schemaCacheEntry)}
+		c.SetLazy(addrs.NewDefaultProvider("test"), benchmarkSchema)
+	}
+}
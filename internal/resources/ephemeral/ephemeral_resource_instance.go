@@ -21,7 +21,13 @@ type ResourceInstance interface {
 	// If the object's life is not extended successfully then Renew returns
 	// error diagnostics explaining why not, and future requests that might
 	// have made use of the object will fail.
-	Renew(ctx context.Context, req providers.EphemeralRenew) (nextRenew *providers.EphemeralRenew, diags tfdiags.Diagnostics)
+	//
+	// If the provider instead defers the renewal, deferred is non-nil and
+	// nextRenew is nil: the caller should stop renewing without treating
+	// this as an error, since a deferred renewal means the provider doesn't
+	// currently have enough information to decide, not that it tried and
+	// failed.
+	Renew(ctx context.Context, req providers.EphemeralRenew) (nextRenew *providers.EphemeralRenew, deferred *providers.Deferred, diags tfdiags.Diagnostics)
 
 	// Close proactively ends the life of the remote object associated with
 	// this resource instance, if possible. For example, if the remote object
@@ -272,6 +272,57 @@ func TestResourcesCancellation(t *testing.T) {
 	}
 }
 
+func TestResourcesRenewDeferred(t *testing.T) {
+	resources := NewResources()
+
+	ephemA := addrs.ResourceInstance{
+		Resource: addrs.Resource{
+			Mode: addrs.EphemeralResourceMode,
+			Type: "test",
+			Name: "a",
+		},
+		Key: addrs.NoKey,
+	}.Absolute(addrs.RootModuleInstance)
+
+	ctx := context.TODO()
+
+	notifyRenew := make(chan string, 10)
+
+	testA := &testResourceInstance{
+		name:          ephemA.String(),
+		renewInterval: 10 * time.Millisecond,
+		notifyRenew:   notifyRenew,
+		deferRenew:    true,
+	}
+
+	resources.RegisterInstance(ctx, ephemA, ResourceInstanceRegistration{
+		Value: cty.ObjectVal(map[string]cty.Value{
+			"test": cty.StringVal("ephemeral.test.a"),
+		}),
+		Impl:    testA,
+		RenewAt: time.Now().Add(10 * time.Millisecond),
+	})
+
+	// Wait for the single renewal the provider defers.
+	<-notifyRenew
+
+	// Give the renew loop a moment to act on the deferral and stop, rather
+	// than scheduling another renew.
+	time.Sleep(50 * time.Millisecond)
+
+	testA.Lock()
+	renewed := testA.renewed
+	testA.Unlock()
+	if renewed != 1 {
+		t.Fatalf("expected exactly 1 renewal before the deferral stopped the loop, got %d", renewed)
+	}
+
+	diags := resources.CloseInstances(ctx, ephemA.ConfigResource())
+	if diags.HasErrors() {
+		t.Fatalf("deferring a renewal should not produce an error, got: %s", diags.ErrWithWarnings())
+	}
+}
+
 type testResourceInstance struct {
 	sync.Mutex
 	name          string
@@ -279,22 +330,32 @@ type testResourceInstance struct {
 	renewed       int
 	notifyRenew   chan string
 	closed        bool
+	deferRenew    bool
 }
 
-func (r *testResourceInstance) Renew(ctx context.Context, req providers.EphemeralRenew) (*providers.EphemeralRenew, tfdiags.Diagnostics) {
-	nextRenew := &providers.EphemeralRenew{
-		RenewAt: time.Now().Add(r.renewInterval),
-	}
+func (r *testResourceInstance) Renew(ctx context.Context, req providers.EphemeralRenew) (*providers.EphemeralRenew, *providers.Deferred, tfdiags.Diagnostics) {
 	r.Lock()
 	defer r.Unlock()
 	r.renewed++
+
+	if r.deferRenew {
+		select {
+		case r.notifyRenew <- r.name:
+		case <-time.After(time.Second):
+		}
+		return nil, &providers.Deferred{Reason: providers.DeferredReasonResourceConfigUnknown}, nil
+	}
+
+	nextRenew := &providers.EphemeralRenew{
+		RenewAt: time.Now().Add(r.renewInterval),
+	}
 	select {
 	case r.notifyRenew <- r.name:
 	case <-time.After(time.Second):
 		// stop renewing if no-one is listening
-		return nil, nil
+		return nil, nil, nil
 	}
-	return nextRenew, nil
+	return nextRenew, nil, nil
 }
 
 func (r *testResourceInstance) Close(ctx context.Context) tfdiags.Diagnostics {
@@ -245,7 +245,7 @@ func (r *resourceInstanceInternal) handleRenewal(ctx context.Context, wg *sync.W
 		case <-t.C:
 			// It's time to renew
 			r.renewMu.Lock()
-			anotherRenew, diags := r.impl.Renew(ctx, *nextRenew)
+			anotherRenew, deferred, diags := r.impl.Renew(ctx, *nextRenew)
 			r.renewDiags.Append(diags)
 			if diags.HasErrors() {
 				// If renewal fails then we'll stop trying to renew.
@@ -253,6 +253,14 @@ func (r *resourceInstanceInternal) handleRenewal(ctx context.Context, wg *sync.W
 				r.renewMu.Unlock()
 				return
 			}
+			if deferred != nil {
+				// A deferred renewal isn't an error: the provider just
+				// doesn't have enough information to renew right now, so
+				// there's nothing further we can do but stop trying.
+				r.renewCancel = noopCancel
+				r.renewMu.Unlock()
+				return
+			}
 			if anotherRenew == nil {
 				// If we don't have another round of renew to do then we'll stop.
 				r.renewCancel = noopCancel
@@ -164,7 +164,7 @@ func ApplyComponentPlan(ctx context.Context, main *Main, plan *plans.Plan, requi
 				return nil, err
 			}
 			// this provider should only be used for selected operations
-			return stubs.OfflineProvider(provider), nil
+			return stubs.OfflineProvider(provider, addr), nil
 		}
 	}
 
@@ -333,7 +333,7 @@ func (c *ComponentConfig) checkValid(ctx context.Context, phase EvalPhase) tfdia
 					return nil, err
 				}
 				// this provider should only be used for selected operations
-				return stubs.OfflineProvider(provider), nil
+				return stubs.OfflineProvider(provider, addr), nil
 			}
 		}
 
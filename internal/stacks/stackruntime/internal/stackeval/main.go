@@ -400,7 +400,7 @@ func (m *Main) ProviderFunctions(ctx context.Context, config *StackConfig) (lang
 				if err != nil {
 					return nil, err
 				}
-				return stubs.OfflineProvider(client), nil
+				return stubs.OfflineProvider(client, addr), nil
 			})
 		}
 	}
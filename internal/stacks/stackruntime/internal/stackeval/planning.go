@@ -158,7 +158,7 @@ func PlanComponentInstance(ctx context.Context, main *Main, state *states.State,
 				return nil, err
 			}
 			// this provider should only be used for selected operations
-			return stubs.OfflineProvider(provider), nil
+			return stubs.OfflineProvider(provider, addr), nil
 		}
 	}
 
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package stubs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// InMemoryProvider is a test-only providers.Interface whose behavior is
+// entirely driven by the function fields a test sets on it before use. A
+// method whose outcome a test can't reasonably predict without a hook (such
+// as ReadResource or ApplyResourceChange) falls back to a hard "not
+// implemented" diagnostic if its Fn field is left nil, so a test doesn't
+// mistake an unstubbed call for a real response. Methods that have a
+// sensible no-op outcome (the validation methods, and the ephemeral
+// resource lifecycle) instead default to a quiet success, so a test only
+// needs to wire up the handful of methods the scenario it's exercising
+// actually calls.
+//
+// It exists for tests that need to drive a full plan/apply-shaped sequence
+// of calls against a fake provider without a real plugin process, and where
+// StaticSchemaProvider's fixed, read-only schema isn't enough because the
+// test also needs to control how individual calls respond.
+type InMemoryProvider struct {
+	Schema                   providers.GetProviderSchemaResponse
+	IdentitySchemas          providers.GetResourceIdentitySchemasResponse
+	ValidateProviderConfigFn func(providers.ValidateProviderConfigRequest) providers.ValidateProviderConfigResponse
+	ValidateResourceConfigFn func(providers.ValidateResourceConfigRequest) providers.ValidateResourceConfigResponse
+	ConfigureProviderFn      func(providers.ConfigureProviderRequest) providers.ConfigureProviderResponse
+	ReadResourceFn           func(providers.ReadResourceRequest) providers.ReadResourceResponse
+	PlanResourceChangeFn     func(providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse
+	ApplyResourceChangeFn    func(providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse
+	ReadDataSourceFn         func(providers.ReadDataSourceRequest) providers.ReadDataSourceResponse
+	CallFunctionFn           func(providers.CallFunctionRequest) providers.CallFunctionResponse
+}
+
+var _ providers.Interface = (*InMemoryProvider)(nil)
+
+func (p *InMemoryProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
+	return p.Schema
+}
+
+func (p *InMemoryProvider) GetResourceIdentitySchemas() providers.GetResourceIdentitySchemasResponse {
+	return p.IdentitySchemas
+}
+
+func (p *InMemoryProvider) ValidateProviderConfig(request providers.ValidateProviderConfigRequest) providers.ValidateProviderConfigResponse {
+	if p.ValidateProviderConfigFn != nil {
+		return p.ValidateProviderConfigFn(request)
+	}
+	return providers.ValidateProviderConfigResponse{}
+}
+
+func (p *InMemoryProvider) ValidateResourceConfig(request providers.ValidateResourceConfigRequest) providers.ValidateResourceConfigResponse {
+	if p.ValidateResourceConfigFn != nil {
+		return p.ValidateResourceConfigFn(request)
+	}
+	return providers.ValidateResourceConfigResponse{}
+}
+
+func (p *InMemoryProvider) ValidateDataResourceConfig(request providers.ValidateDataResourceConfigRequest) providers.ValidateDataResourceConfigResponse {
+	return providers.ValidateDataResourceConfigResponse{}
+}
+
+func (p *InMemoryProvider) ValidateEphemeralResourceConfig(request providers.ValidateEphemeralResourceConfigRequest) providers.ValidateEphemeralResourceConfigResponse {
+	return providers.ValidateEphemeralResourceConfigResponse{}
+}
+
+func (p *InMemoryProvider) ValidateListResourceConfig(request providers.ValidateListResourceConfigRequest) providers.ValidateListResourceConfigResponse {
+	return providers.ValidateListResourceConfigResponse{}
+}
+
+func (p *InMemoryProvider) UpgradeResourceState(request providers.UpgradeResourceStateRequest) providers.UpgradeResourceStateResponse {
+	return providers.UpgradeResourceStateResponse{Diagnostics: p.unimplementedDiags("UpgradeResourceState")}
+}
+
+func (p *InMemoryProvider) UpgradeResourceIdentity(request providers.UpgradeResourceIdentityRequest) providers.UpgradeResourceIdentityResponse {
+	return providers.UpgradeResourceIdentityResponse{Diagnostics: p.unimplementedDiags("UpgradeResourceIdentity")}
+}
+
+func (p *InMemoryProvider) ConfigureProvider(request providers.ConfigureProviderRequest) providers.ConfigureProviderResponse {
+	if p.ConfigureProviderFn != nil {
+		return p.ConfigureProviderFn(request)
+	}
+	return providers.ConfigureProviderResponse{Diagnostics: p.unimplementedDiags("ConfigureProvider")}
+}
+
+func (p *InMemoryProvider) Stop() error {
+	return nil
+}
+
+func (p *InMemoryProvider) ReadResource(request providers.ReadResourceRequest) providers.ReadResourceResponse {
+	if p.ReadResourceFn != nil {
+		return p.ReadResourceFn(request)
+	}
+	return providers.ReadResourceResponse{Diagnostics: p.unimplementedDiags("ReadResource")}
+}
+
+func (p *InMemoryProvider) PlanResourceChange(request providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+	if p.PlanResourceChangeFn != nil {
+		return p.PlanResourceChangeFn(request)
+	}
+	return providers.PlanResourceChangeResponse{Diagnostics: p.unimplementedDiags("PlanResourceChange")}
+}
+
+func (p *InMemoryProvider) ApplyResourceChange(request providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+	if p.ApplyResourceChangeFn != nil {
+		return p.ApplyResourceChangeFn(request)
+	}
+	return providers.ApplyResourceChangeResponse{Diagnostics: p.unimplementedDiags("ApplyResourceChange")}
+}
+
+func (p *InMemoryProvider) ImportResourceState(request providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
+	return providers.ImportResourceStateResponse{Diagnostics: p.unimplementedDiags("ImportResourceState")}
+}
+
+func (p *InMemoryProvider) MoveResourceState(request providers.MoveResourceStateRequest) providers.MoveResourceStateResponse {
+	return providers.MoveResourceStateResponse{Diagnostics: p.unimplementedDiags("MoveResourceState")}
+}
+
+func (p *InMemoryProvider) ReadDataSource(request providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
+	if p.ReadDataSourceFn != nil {
+		return p.ReadDataSourceFn(request)
+	}
+	return providers.ReadDataSourceResponse{Diagnostics: p.unimplementedDiags("ReadDataSource")}
+}
+
+func (p *InMemoryProvider) OpenEphemeralResource(request providers.OpenEphemeralResourceRequest) providers.OpenEphemeralResourceResponse {
+	return providers.OpenEphemeralResourceResponse{}
+}
+
+func (p *InMemoryProvider) RenewEphemeralResource(request providers.RenewEphemeralResourceRequest) providers.RenewEphemeralResourceResponse {
+	return providers.RenewEphemeralResourceResponse{}
+}
+
+func (p *InMemoryProvider) CloseEphemeralResource(request providers.CloseEphemeralResourceRequest) providers.CloseEphemeralResourceResponse {
+	return providers.CloseEphemeralResourceResponse{}
+}
+
+func (p *InMemoryProvider) CallFunction(request providers.CallFunctionRequest) providers.CallFunctionResponse {
+	if p.CallFunctionFn != nil {
+		return p.CallFunctionFn(request)
+	}
+	return providers.CallFunctionResponse{Err: p.unimplementedDiags("CallFunction").Err()}
+}
+
+func (p *InMemoryProvider) ListResource(request providers.ListResourceRequest) providers.ListResourceResponse {
+	return providers.ListResourceResponse{Diagnostics: p.unimplementedDiags("ListResource")}
+}
+
+func (p *InMemoryProvider) ValidateStateStoreConfig(request providers.ValidateStateStoreConfigRequest) providers.ValidateStateStoreConfigResponse {
+	return providers.ValidateStateStoreConfigResponse{}
+}
+
+func (p *InMemoryProvider) ConfigureStateStore(request providers.ConfigureStateStoreRequest) providers.ConfigureStateStoreResponse {
+	return providers.ConfigureStateStoreResponse{Diagnostics: p.unimplementedDiags("ConfigureStateStore")}
+}
+
+func (p *InMemoryProvider) GetStates(request providers.GetStatesRequest) providers.GetStatesResponse {
+	return providers.GetStatesResponse{Diagnostics: p.unimplementedDiags("GetStates")}
+}
+
+func (p *InMemoryProvider) DeleteState(request providers.DeleteStateRequest) providers.DeleteStateResponse {
+	return providers.DeleteStateResponse{Diagnostics: p.unimplementedDiags("DeleteState")}
+}
+
+func (p *InMemoryProvider) PlanAction(request providers.PlanActionRequest) providers.PlanActionResponse {
+	return providers.PlanActionResponse{Diagnostics: p.unimplementedDiags("PlanAction")}
+}
+
+func (p *InMemoryProvider) InvokeAction(request providers.InvokeActionRequest) providers.InvokeActionResponse {
+	return providers.InvokeActionResponse{Diagnostics: p.unimplementedDiags("InvokeAction")}
+}
+
+func (p *InMemoryProvider) ValidateActionConfig(request providers.ValidateActionConfigRequest) providers.ValidateActionConfigResponse {
+	return providers.ValidateActionConfigResponse{}
+}
+
+func (p *InMemoryProvider) Close() error {
+	return nil
+}
+
+// unimplementedDiags builds the diagnostic returned by every method a test
+// hasn't wired a hook up for, naming the method so a failure here points at
+// exactly what the test exercised that it forgot to stub.
+func (p *InMemoryProvider) unimplementedDiags(method string) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	diags = diags.Append(tfdiags.Sourceless(
+		tfdiags.Error,
+		fmt.Sprintf("Called %s on an in-memory test provider", method),
+		fmt.Sprintf("InMemoryProvider has no hook set for %s; set the corresponding Fn field before exercising this code path.", method),
+	))
+	return diags
+}
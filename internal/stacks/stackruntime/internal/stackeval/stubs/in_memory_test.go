@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package stubs
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/providers"
+)
+
+func TestInMemoryProvider_PlanResourceChange(t *testing.T) {
+	schema := providers.GetProviderSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"test_thing": {
+				Body: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id":   {Type: cty.String, Computed: true},
+						"name": {Type: cty.String, Required: true},
+					},
+				},
+			},
+		},
+	}
+
+	p := &InMemoryProvider{
+		Schema: schema,
+		PlanResourceChangeFn: func(request providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+			planned := request.ProposedNewState.AsValueMap()
+			planned["id"] = cty.UnknownVal(cty.String)
+			return providers.PlanResourceChangeResponse{
+				PlannedState: cty.ObjectVal(planned),
+			}
+		},
+	}
+
+	got := p.GetProviderSchema()
+	if len(got.ResourceTypes) != 1 {
+		t.Fatalf("expected 1 resource type, got %d", len(got.ResourceTypes))
+	}
+
+	proposed := cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.UnknownVal(cty.String),
+		"name": cty.StringVal("example"),
+	})
+	resp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
+		TypeName:         "test_thing",
+		ProposedNewState: proposed,
+	})
+	if resp.Diagnostics.HasErrors() {
+		t.Fatal(resp.Diagnostics.Err())
+	}
+	if name := resp.PlannedState.GetAttr("name"); name.AsString() != "example" {
+		t.Fatalf("expected name %q, got %q", "example", name.AsString())
+	}
+
+	// A method with no hook set and no sensible no-op should hard-error
+	// rather than panic, so a test can tell it exercised a code path it
+	// forgot to stub.
+	readResp := p.ReadResource(providers.ReadResourceRequest{TypeName: "test_thing"})
+	if !readResp.Diagnostics.HasErrors() {
+		t.Fatal("expected an error for an unstubbed method")
+	}
+
+	// A method with a sensible no-op outcome should succeed quietly with
+	// no hook set, so a test doesn't need to stub every validation and
+	// ephemeral-resource-lifecycle call it never cares about.
+	validateResp := p.ValidateResourceConfig(providers.ValidateResourceConfigRequest{TypeName: "test_thing"})
+	if validateResp.Diagnostics.HasErrors() {
+		t.Fatalf("expected no error for an unstubbed no-op method, got %s", validateResp.Diagnostics.Err())
+	}
+	openResp := p.OpenEphemeralResource(providers.OpenEphemeralResourceRequest{TypeName: "test_thing"})
+	if openResp.Diagnostics.HasErrors() {
+		t.Fatalf("expected no error for an unstubbed no-op method, got %s", openResp.Diagnostics.Err())
+	}
+}
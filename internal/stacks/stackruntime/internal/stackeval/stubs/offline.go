@@ -4,6 +4,9 @@
 package stubs
 
 import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/internal/addrs"
 	"github.com/hashicorp/terraform/internal/providers"
 	"github.com/hashicorp/terraform/internal/tfdiags"
 )
@@ -19,14 +22,83 @@ import (
 // that should be used instead.
 type offlineProvider struct {
 	unconfiguredClient providers.Interface
+	providerAddr       addrs.Provider
+
+	// allowIdentityReads opts this stub into forwarding ReadResource to
+	// unconfiguredClient for identity-only reads: a request with a non-null
+	// CurrentIdentity and a null PriorState. Some providers can resolve a
+	// resource's current state from its identity alone, without any
+	// provider configuration, which stacks reconciliation flows need while
+	// the real provider is still offline. Everything else about
+	// ReadResource remains a hard error.
+	allowIdentityReads bool
+
+	// allowConfigValidation opts this stub into forwarding
+	// ValidateProviderConfig to unconfiguredClient, which validates the
+	// config's shape without actually configuring the provider. Stacks
+	// sometimes needs to validate a provider config while the real
+	// provider is still offline, and unconfiguredClient can do that safely
+	// since it never sends the config anywhere.
+	allowConfigValidation bool
+}
+
+func OfflineProvider(unconfiguredClient providers.Interface, providerAddr addrs.Provider) providers.Interface {
+	return &offlineProvider{
+		unconfiguredClient: unconfiguredClient,
+		providerAddr:       providerAddr,
+	}
 }
 
-func OfflineProvider(unconfiguredClient providers.Interface) providers.Interface {
+// OfflineProviderWithIdentityReads is like OfflineProvider, but also allows
+// ReadResource calls through to unconfiguredClient when they carry only a
+// resource identity and no prior state, for reconciliation flows that need
+// to look a resource up by identity while its provider is offline.
+func OfflineProviderWithIdentityReads(unconfiguredClient providers.Interface, providerAddr addrs.Provider) providers.Interface {
 	return &offlineProvider{
 		unconfiguredClient: unconfiguredClient,
+		providerAddr:       providerAddr,
+		allowIdentityReads: true,
+	}
+}
+
+// OfflineProviderWithConfigValidation is like OfflineProvider, but also
+// allows ValidateProviderConfig calls through to unconfiguredClient, for
+// callers that need to validate a provider config's shape while the real
+// provider is still offline.
+func OfflineProviderWithConfigValidation(unconfiguredClient providers.Interface, providerAddr addrs.Provider) providers.Interface {
+	return &offlineProvider{
+		unconfiguredClient:    unconfiguredClient,
+		providerAddr:          providerAddr,
+		allowConfigValidation: true,
 	}
 }
 
+// unconfiguredDiags builds the diagnostic returned by every method this stub
+// refuses to perform, naming the provider that was called and, when typeName
+// is non-empty, the specific resource/data source/action type that triggered
+// it, so a failure here points at the exact offending address instead of a
+// generic bug report.
+func (o *offlineProvider) unconfiguredDiags(method, typeName string) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	detail := fmt.Sprintf(
+		"Cannot call %s because provider %s is not configured. This is a bug in Terraform - please report it.",
+		method, o.providerAddr,
+	)
+	if typeName != "" {
+		detail = fmt.Sprintf(
+			"Cannot call %s for %q because provider %s is not configured. This is a bug in Terraform - please report it.",
+			method, typeName, o.providerAddr,
+		)
+	}
+	diags = diags.Append(tfdiags.AttributeValue(
+		tfdiags.Error,
+		fmt.Sprintf("Called %s on an unconfigured provider", method),
+		detail,
+		nil, // nil attribute path means the overall configuration block
+	))
+	return diags
+}
+
 func (o *offlineProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
 	// We do actually use the schema to work out which functions are available
 	// and whether cross-resource moves are even supported.
@@ -37,109 +109,56 @@ func (o *offlineProvider) GetResourceIdentitySchemas() providers.GetResourceIden
 	return o.unconfiguredClient.GetResourceIdentitySchemas()
 }
 
-func (o *offlineProvider) ValidateProviderConfig(_ providers.ValidateProviderConfigRequest) providers.ValidateProviderConfigResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called ValidateProviderConfig on an unconfigured provider",
-		"Cannot validate provider configuration because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) ValidateProviderConfig(request providers.ValidateProviderConfigRequest) providers.ValidateProviderConfigResponse {
+	if o.allowConfigValidation {
+		return o.unconfiguredClient.ValidateProviderConfig(request)
+	}
 	return providers.ValidateProviderConfigResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("ValidateProviderConfig", ""),
 	}
 }
 
-func (o *offlineProvider) ValidateResourceConfig(_ providers.ValidateResourceConfigRequest) providers.ValidateResourceConfigResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called ValidateResourceConfig on an unconfigured provider",
-		"Cannot validate resource configuration because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) ValidateResourceConfig(request providers.ValidateResourceConfigRequest) providers.ValidateResourceConfigResponse {
 	return providers.ValidateResourceConfigResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("ValidateResourceConfig", request.TypeName),
 	}
 }
 
-func (o *offlineProvider) ValidateDataResourceConfig(_ providers.ValidateDataResourceConfigRequest) providers.ValidateDataResourceConfigResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called ValidateDataResourceConfig on an unconfigured provider",
-		"Cannot validate data source configuration because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) ValidateDataResourceConfig(request providers.ValidateDataResourceConfigRequest) providers.ValidateDataResourceConfigResponse {
 	return providers.ValidateDataResourceConfigResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("ValidateDataResourceConfig", request.TypeName),
 	}
 }
 
 // ValidateEphemeralResourceConfig implements providers.Interface.
-func (p *offlineProvider) ValidateEphemeralResourceConfig(providers.ValidateEphemeralResourceConfigRequest) providers.ValidateEphemeralResourceConfigResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called ValidateEphemeralResourceConfig on an unconfigured provider",
-		"Cannot validate this resource config because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) ValidateEphemeralResourceConfig(request providers.ValidateEphemeralResourceConfigRequest) providers.ValidateEphemeralResourceConfigResponse {
 	return providers.ValidateEphemeralResourceConfigResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("ValidateEphemeralResourceConfig", request.TypeName),
 	}
 }
 
 // ValidateListResourceConfig implements providers.Interface.
-func (p *offlineProvider) ValidateListResourceConfig(providers.ValidateListResourceConfigRequest) providers.ValidateListResourceConfigResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called ValidateListResourceConfig on an unconfigured provider",
-		"Cannot validate this resource config because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) ValidateListResourceConfig(request providers.ValidateListResourceConfigRequest) providers.ValidateListResourceConfigResponse {
 	return providers.ValidateListResourceConfigResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("ValidateListResourceConfig", request.TypeName),
 	}
 }
 
-func (o *offlineProvider) UpgradeResourceState(_ providers.UpgradeResourceStateRequest) providers.UpgradeResourceStateResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called UpgradeResourceState on an unconfigured provider",
-		"Cannot upgrade the state of this resource because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
-	return providers.UpgradeResourceStateResponse{
-		Diagnostics: diags,
-	}
+// UpgradeResourceState doesn't depend on any provider configuration, so we
+// pass it through to the unconfigured client rather than stubbing it out.
+func (o *offlineProvider) UpgradeResourceState(request providers.UpgradeResourceStateRequest) providers.UpgradeResourceStateResponse {
+	return o.unconfiguredClient.UpgradeResourceState(request)
 }
 
-func (o *offlineProvider) UpgradeResourceIdentity(_ providers.UpgradeResourceIdentityRequest) providers.UpgradeResourceIdentityResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called UpgradeResourceIdentity on an unconfigured provider",
-		"Cannot upgrade the state of this resource because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) UpgradeResourceIdentity(request providers.UpgradeResourceIdentityRequest) providers.UpgradeResourceIdentityResponse {
 	return providers.UpgradeResourceIdentityResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("UpgradeResourceIdentity", request.TypeName),
 	}
 }
 
 func (o *offlineProvider) ConfigureProvider(_ providers.ConfigureProviderRequest) providers.ConfigureProviderResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called ConfigureProvider on an unconfigured provider",
-		"Cannot configure this provider because it is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
 	return providers.ConfigureProviderResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("ConfigureProvider", ""),
 	}
 }
 
@@ -148,55 +167,30 @@ func (o *offlineProvider) Stop() error {
 	return o.unconfiguredClient.Stop()
 }
 
-func (o *offlineProvider) ReadResource(_ providers.ReadResourceRequest) providers.ReadResourceResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called ReadResource on an unconfigured provider",
-		"Cannot read from this resource because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) ReadResource(request providers.ReadResourceRequest) providers.ReadResourceResponse {
+	if o.allowIdentityReads && !request.CurrentIdentity.IsNull() && request.PriorState.IsNull() {
+		return o.unconfiguredClient.ReadResource(request)
+	}
 	return providers.ReadResourceResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("ReadResource", request.TypeName),
 	}
 }
 
-func (o *offlineProvider) PlanResourceChange(_ providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called PlanResourceChange on an unconfigured provider",
-		"Cannot plan changes to this resource because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) PlanResourceChange(request providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
 	return providers.PlanResourceChangeResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("PlanResourceChange", request.TypeName),
 	}
 }
 
-func (o *offlineProvider) ApplyResourceChange(_ providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called ApplyResourceChange on an unconfigured provider",
-		"Cannot apply changes to this resource because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) ApplyResourceChange(request providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
 	return providers.ApplyResourceChangeResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("ApplyResourceChange", request.TypeName),
 	}
 }
 
-func (o *offlineProvider) ImportResourceState(_ providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called ImportResourceState on an unconfigured provider",
-		"Cannot import an existing object into this resource because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) ImportResourceState(request providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
 	return providers.ImportResourceStateResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("ImportResourceState", request.TypeName),
 	}
 }
 
@@ -204,42 +198,28 @@ func (o *offlineProvider) MoveResourceState(request providers.MoveResourceStateR
 	return o.unconfiguredClient.MoveResourceState(request)
 }
 
-func (o *offlineProvider) ReadDataSource(_ providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called ReadDataSource on an unconfigured provider",
-		"Cannot read from this data source because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) ReadDataSource(request providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
 	return providers.ReadDataSourceResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("ReadDataSource", request.TypeName),
 	}
 }
 
 // OpenEphemeralResource implements providers.Interface.
-func (u *offlineProvider) OpenEphemeralResource(providers.OpenEphemeralResourceRequest) providers.OpenEphemeralResourceResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called OpenEphemeralResource on an unconfigured provider",
-		"Cannot open this resource instance because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) OpenEphemeralResource(request providers.OpenEphemeralResourceRequest) providers.OpenEphemeralResourceResponse {
 	return providers.OpenEphemeralResourceResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("OpenEphemeralResource", request.TypeName),
 	}
 }
 
 // RenewEphemeralResource implements providers.Interface.
-func (u *offlineProvider) RenewEphemeralResource(providers.RenewEphemeralResourceRequest) providers.RenewEphemeralResourceResponse {
+func (o *offlineProvider) RenewEphemeralResource(providers.RenewEphemeralResourceRequest) providers.RenewEphemeralResourceResponse {
 	// We don't have anything to do here because OpenEphemeralResource didn't really
 	// actually "open" anything.
 	return providers.RenewEphemeralResourceResponse{}
 }
 
 // CloseEphemeralResource implements providers.Interface.
-func (u *offlineProvider) CloseEphemeralResource(providers.CloseEphemeralResourceRequest) providers.CloseEphemeralResourceResponse {
+func (o *offlineProvider) CloseEphemeralResource(providers.CloseEphemeralResourceRequest) providers.CloseEphemeralResourceResponse {
 	// We don't have anything to do here because OpenEphemeralResource didn't really
 	// actually "open" anything.
 	return providers.CloseEphemeralResourceResponse{}
@@ -249,112 +229,58 @@ func (o *offlineProvider) CallFunction(request providers.CallFunctionRequest) pr
 	return o.unconfiguredClient.CallFunction(request)
 }
 
-func (o *offlineProvider) ListResource(providers.ListResourceRequest) providers.ListResourceResponse {
-	var resp providers.ListResourceResponse
-	resp.Diagnostics = resp.Diagnostics.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called ListResource on an unconfigured provider",
-		"Cannot list this resource because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
-	return resp
+func (o *offlineProvider) ListResource(request providers.ListResourceRequest) providers.ListResourceResponse {
+	return providers.ListResourceResponse{
+		Diagnostics: o.unconfiguredDiags("ListResource", request.TypeName),
+	}
 }
 
 // ValidateStateStoreConfig implements providers.Interface.
-func (o *offlineProvider) ValidateStateStoreConfig(providers.ValidateStateStoreConfigRequest) providers.ValidateStateStoreConfigResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called ValidateStateStoreConfig on an unconfigured provider",
-		"Cannot validate state store because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) ValidateStateStoreConfig(request providers.ValidateStateStoreConfigRequest) providers.ValidateStateStoreConfigResponse {
 	return providers.ValidateStateStoreConfigResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("ValidateStateStoreConfig", request.TypeName),
 	}
 }
 
 // ConfigureStateStore implements providers.Interface.
-func (o *offlineProvider) ConfigureStateStore(providers.ConfigureStateStoreRequest) providers.ConfigureStateStoreResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called ConfigureStateStore on an unconfigured provider",
-		"Cannot configure state store because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) ConfigureStateStore(request providers.ConfigureStateStoreRequest) providers.ConfigureStateStoreResponse {
 	return providers.ConfigureStateStoreResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("ConfigureStateStore", request.TypeName),
 	}
 }
 
 // GetStates implements providers.Interface.
-func (o *offlineProvider) GetStates(providers.GetStatesRequest) providers.GetStatesResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called GetStates on an unconfigured provider",
-		"Cannot list states managed by this state store because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) GetStates(request providers.GetStatesRequest) providers.GetStatesResponse {
 	return providers.GetStatesResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("GetStates", request.TypeName),
 	}
 }
 
 // DeleteState implements providers.Interface.
-func (o *offlineProvider) DeleteState(providers.DeleteStateRequest) providers.DeleteStateResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called DeleteState on an unconfigured provider",
-		"Cannot use this state store to delete a state because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
+func (o *offlineProvider) DeleteState(request providers.DeleteStateRequest) providers.DeleteStateResponse {
 	return providers.DeleteStateResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("DeleteState", request.TypeName),
 	}
 }
 
 // PlanAction implements providers.Interface.
 func (o *offlineProvider) PlanAction(request providers.PlanActionRequest) providers.PlanActionResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called PlanAction on an unconfigured provider",
-		"Cannot plan this action because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
 	return providers.PlanActionResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("PlanAction", request.ActionType),
 	}
 }
 
 // InvokeAction implements providers.Interface.
 func (o *offlineProvider) InvokeAction(request providers.InvokeActionRequest) providers.InvokeActionResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called InvokeAction on an unconfigured provider",
-		"Cannot invoke this action because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
 	return providers.InvokeActionResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("InvokeAction", request.ActionType),
 	}
 }
 
 // InvokeAction implements providers.Interface.
 func (o *offlineProvider) ValidateActionConfig(request providers.ValidateActionConfigRequest) providers.ValidateActionConfigResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called ValidateActionConfig on an unconfigured provider",
-		"Cannot invoke this action because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
 	return providers.ValidateActionConfigResponse{
-		Diagnostics: diags,
+		Diagnostics: o.unconfiguredDiags("ValidateActionConfig", request.TypeName),
 	}
 }
 
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package stubs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/providers"
+	testing_provider "github.com/hashicorp/terraform/internal/providers/testing"
+)
+
+func TestOfflineProvider_ValidateProviderConfig(t *testing.T) {
+	providerAddr := addrs.NewDefaultProvider("test")
+	req := providers.ValidateProviderConfigRequest{}
+
+	t.Run("blocked by default", func(t *testing.T) {
+		unconfigured := &testing_provider.MockProvider{}
+		p := OfflineProvider(unconfigured, providerAddr)
+
+		resp := p.ValidateProviderConfig(req)
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected an error for an unconfigured provider")
+		}
+		if unconfigured.ValidateProviderConfigCalled {
+			t.Fatal("expected unconfiguredClient not to be called")
+		}
+	})
+
+	t.Run("forwarded when allowed", func(t *testing.T) {
+		unconfigured := &testing_provider.MockProvider{}
+		p := OfflineProviderWithConfigValidation(unconfigured, providerAddr)
+
+		resp := p.ValidateProviderConfig(req)
+		if resp.Diagnostics.HasErrors() {
+			t.Fatal(resp.Diagnostics.Err())
+		}
+		if !unconfigured.ValidateProviderConfigCalled {
+			t.Fatal("expected unconfiguredClient to be called")
+		}
+	})
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package stubs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// staticSchemaProvider is a stub providers.Interface that serves a fixed
+// schema handed to it at construction time, and hard errors for every other
+// method except Stop and Close, which return nil. It exists so that tests can construct an offlineProvider backed by
+// an arbitrary schema without needing a live plugin process to ask for one.
+type staticSchemaProvider struct {
+	schema providers.GetProviderSchemaResponse
+}
+
+var _ providers.Interface = (*staticSchemaProvider)(nil)
+
+// StaticSchemaProvider returns a providers.Interface that serves schema from
+// GetProviderSchema and GetResourceIdentitySchemas, and returns a hard error
+// for every other method except Stop and Close, which return nil. It's intended as the unconfiguredClient
+// passed to OfflineProvider in tests that need a specific schema shape.
+func StaticSchemaProvider(schema providers.GetProviderSchemaResponse) providers.Interface {
+	return &staticSchemaProvider{schema: schema}
+}
+
+func (s *staticSchemaProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
+	return s.schema
+}
+
+func (s *staticSchemaProvider) GetResourceIdentitySchemas() providers.GetResourceIdentitySchemasResponse {
+	identityTypes := make(map[string]providers.IdentitySchema)
+	for name, resSchema := range s.schema.ResourceTypes {
+		if resSchema.Identity == nil {
+			continue
+		}
+		identityTypes[name] = providers.IdentitySchema{
+			Version: resSchema.IdentityVersion,
+			Body:    resSchema.Identity,
+		}
+	}
+	return providers.GetResourceIdentitySchemasResponse{IdentityTypes: identityTypes}
+}
+
+// unimplementedDiags builds the diagnostic returned by every method this
+// stub doesn't actually implement, naming the method so a failure here
+// points at exactly what a test exercised that StaticSchemaProvider can't
+// really serve.
+func (s *staticSchemaProvider) unimplementedDiags(method string) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	diags = diags.Append(tfdiags.Sourceless(
+		tfdiags.Error,
+		fmt.Sprintf("Called %s on a static schema provider", method),
+		fmt.Sprintf("StaticSchemaProvider only serves a fixed schema for tests; it has no real implementation of %s.", method),
+	))
+	return diags
+}
+
+func (s *staticSchemaProvider) ValidateProviderConfig(request providers.ValidateProviderConfigRequest) providers.ValidateProviderConfigResponse {
+	return providers.ValidateProviderConfigResponse{Diagnostics: s.unimplementedDiags("ValidateProviderConfig")}
+}
+
+func (s *staticSchemaProvider) ValidateResourceConfig(request providers.ValidateResourceConfigRequest) providers.ValidateResourceConfigResponse {
+	return providers.ValidateResourceConfigResponse{Diagnostics: s.unimplementedDiags("ValidateResourceConfig")}
+}
+
+func (s *staticSchemaProvider) ValidateDataResourceConfig(request providers.ValidateDataResourceConfigRequest) providers.ValidateDataResourceConfigResponse {
+	return providers.ValidateDataResourceConfigResponse{Diagnostics: s.unimplementedDiags("ValidateDataResourceConfig")}
+}
+
+func (s *staticSchemaProvider) ValidateEphemeralResourceConfig(request providers.ValidateEphemeralResourceConfigRequest) providers.ValidateEphemeralResourceConfigResponse {
+	return providers.ValidateEphemeralResourceConfigResponse{Diagnostics: s.unimplementedDiags("ValidateEphemeralResourceConfig")}
+}
+
+func (s *staticSchemaProvider) ValidateListResourceConfig(request providers.ValidateListResourceConfigRequest) providers.ValidateListResourceConfigResponse {
+	return providers.ValidateListResourceConfigResponse{Diagnostics: s.unimplementedDiags("ValidateListResourceConfig")}
+}
+
+func (s *staticSchemaProvider) UpgradeResourceState(request providers.UpgradeResourceStateRequest) providers.UpgradeResourceStateResponse {
+	return providers.UpgradeResourceStateResponse{Diagnostics: s.unimplementedDiags("UpgradeResourceState")}
+}
+
+func (s *staticSchemaProvider) UpgradeResourceIdentity(request providers.UpgradeResourceIdentityRequest) providers.UpgradeResourceIdentityResponse {
+	return providers.UpgradeResourceIdentityResponse{Diagnostics: s.unimplementedDiags("UpgradeResourceIdentity")}
+}
+
+func (s *staticSchemaProvider) ConfigureProvider(request providers.ConfigureProviderRequest) providers.ConfigureProviderResponse {
+	return providers.ConfigureProviderResponse{Diagnostics: s.unimplementedDiags("ConfigureProvider")}
+}
+
+func (s *staticSchemaProvider) Stop() error {
+	return nil
+}
+
+func (s *staticSchemaProvider) ReadResource(request providers.ReadResourceRequest) providers.ReadResourceResponse {
+	return providers.ReadResourceResponse{Diagnostics: s.unimplementedDiags("ReadResource")}
+}
+
+func (s *staticSchemaProvider) PlanResourceChange(request providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+	return providers.PlanResourceChangeResponse{Diagnostics: s.unimplementedDiags("PlanResourceChange")}
+}
+
+func (s *staticSchemaProvider) ApplyResourceChange(request providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+	return providers.ApplyResourceChangeResponse{Diagnostics: s.unimplementedDiags("ApplyResourceChange")}
+}
+
+func (s *staticSchemaProvider) ImportResourceState(request providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
+	return providers.ImportResourceStateResponse{Diagnostics: s.unimplementedDiags("ImportResourceState")}
+}
+
+func (s *staticSchemaProvider) MoveResourceState(request providers.MoveResourceStateRequest) providers.MoveResourceStateResponse {
+	return providers.MoveResourceStateResponse{Diagnostics: s.unimplementedDiags("MoveResourceState")}
+}
+
+func (s *staticSchemaProvider) ReadDataSource(request providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
+	return providers.ReadDataSourceResponse{Diagnostics: s.unimplementedDiags("ReadDataSource")}
+}
+
+func (s *staticSchemaProvider) OpenEphemeralResource(request providers.OpenEphemeralResourceRequest) providers.OpenEphemeralResourceResponse {
+	return providers.OpenEphemeralResourceResponse{Diagnostics: s.unimplementedDiags("OpenEphemeralResource")}
+}
+
+func (s *staticSchemaProvider) RenewEphemeralResource(request providers.RenewEphemeralResourceRequest) providers.RenewEphemeralResourceResponse {
+	return providers.RenewEphemeralResourceResponse{Diagnostics: s.unimplementedDiags("RenewEphemeralResource")}
+}
+
+func (s *staticSchemaProvider) CloseEphemeralResource(request providers.CloseEphemeralResourceRequest) providers.CloseEphemeralResourceResponse {
+	return providers.CloseEphemeralResourceResponse{Diagnostics: s.unimplementedDiags("CloseEphemeralResource")}
+}
+
+func (s *staticSchemaProvider) CallFunction(request providers.CallFunctionRequest) providers.CallFunctionResponse {
+	return providers.CallFunctionResponse{Err: s.unimplementedDiags("CallFunction").Err()}
+}
+
+func (s *staticSchemaProvider) ListResource(request providers.ListResourceRequest) providers.ListResourceResponse {
+	return providers.ListResourceResponse{Diagnostics: s.unimplementedDiags("ListResource")}
+}
+
+func (s *staticSchemaProvider) ValidateStateStoreConfig(request providers.ValidateStateStoreConfigRequest) providers.ValidateStateStoreConfigResponse {
+	return providers.ValidateStateStoreConfigResponse{Diagnostics: s.unimplementedDiags("ValidateStateStoreConfig")}
+}
+
+func (s *staticSchemaProvider) ConfigureStateStore(request providers.ConfigureStateStoreRequest) providers.ConfigureStateStoreResponse {
+	return providers.ConfigureStateStoreResponse{Diagnostics: s.unimplementedDiags("ConfigureStateStore")}
+}
+
+func (s *staticSchemaProvider) GetStates(request providers.GetStatesRequest) providers.GetStatesResponse {
+	return providers.GetStatesResponse{Diagnostics: s.unimplementedDiags("GetStates")}
+}
+
+func (s *staticSchemaProvider) DeleteState(request providers.DeleteStateRequest) providers.DeleteStateResponse {
+	return providers.DeleteStateResponse{Diagnostics: s.unimplementedDiags("DeleteState")}
+}
+
+func (s *staticSchemaProvider) PlanAction(request providers.PlanActionRequest) providers.PlanActionResponse {
+	return providers.PlanActionResponse{Diagnostics: s.unimplementedDiags("PlanAction")}
+}
+
+func (s *staticSchemaProvider) InvokeAction(request providers.InvokeActionRequest) providers.InvokeActionResponse {
+	return providers.InvokeActionResponse{Diagnostics: s.unimplementedDiags("InvokeAction")}
+}
+
+func (s *staticSchemaProvider) ValidateActionConfig(request providers.ValidateActionConfigRequest) providers.ValidateActionConfigResponse {
+	return providers.ValidateActionConfigResponse{Diagnostics: s.unimplementedDiags("ValidateActionConfig")}
+}
+
+func (s *staticSchemaProvider) Close() error {
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package stubs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestStaticSchemaProvider(t *testing.T) {
+	schema := providers.GetProviderSchemaResponse{
+		Provider: providers.Schema{
+			Body: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"attr": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+		ResourceTypes: map[string]providers.Schema{
+			"test_thing": {
+				Body: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id": {Type: cty.String, Computed: true},
+					},
+				},
+				IdentityVersion: 1,
+				Identity: &configschema.Object{
+					Attributes: map[string]*configschema.Attribute{
+						"id": {Type: cty.String, Required: true},
+					},
+				},
+			},
+			"test_no_identity": {
+				Body: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id": {Type: cty.String, Computed: true},
+					},
+				},
+			},
+		},
+	}
+
+	stub := StaticSchemaProvider(schema)
+
+	t.Run("serves the fixed schema", func(t *testing.T) {
+		got := stub.GetProviderSchema()
+		if len(got.ResourceTypes) != 2 {
+			t.Fatalf("expected 2 resource types, got %d", len(got.ResourceTypes))
+		}
+	})
+
+	t.Run("derives identity schemas from the fixed schema", func(t *testing.T) {
+		got := stub.GetResourceIdentitySchemas()
+		if _, ok := got.IdentityTypes["test_thing"]; !ok {
+			t.Fatal("expected test_thing to have an identity schema")
+		}
+		if _, ok := got.IdentityTypes["test_no_identity"]; ok {
+			t.Fatal("expected test_no_identity not to have an identity schema")
+		}
+	})
+
+	t.Run("hard errors for everything else", func(t *testing.T) {
+		resp := stub.ConfigureProvider(providers.ConfigureProviderRequest{})
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected an error diagnostic")
+		}
+	})
+
+	t.Run("wrapped in OfflineProvider", func(t *testing.T) {
+		providerAddr := addrs.NewDefaultProvider("test")
+		p := OfflineProvider(stub, providerAddr)
+
+		// GetProviderSchema is forwarded straight through.
+		got := p.GetProviderSchema()
+		if len(got.ResourceTypes) != 2 {
+			t.Fatalf("expected 2 resource types, got %d", len(got.ResourceTypes))
+		}
+
+		// CallFunction is forwarded to the unconfigured client, so the
+		// error we see here should be staticSchemaProvider's, not
+		// offlineProvider's generic "not configured" one.
+		resp := p.CallFunction(providers.CallFunctionRequest{FunctionName: "example"})
+		if resp.Err == nil {
+			t.Fatal("expected an error")
+		}
+		if got := resp.Err.Error(); !strings.Contains(got, "static schema provider") {
+			t.Fatalf("expected CallFunction to be forwarded to the static schema provider, got: %s", got)
+		}
+	})
+}
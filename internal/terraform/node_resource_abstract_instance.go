@@ -613,7 +613,7 @@ func (n *NodeAbstractResourceInstance) writeChange(ctx EvalContext, change *plan
 
 // refresh does a refresh for a resource
 // if the second return value is non-nil, the refresh is deferred
-func (n *NodeAbstractResourceInstance) refresh(ctx EvalContext, deposedKey states.DeposedKey, state *states.ResourceInstanceObject, deferralAllowed bool) (*states.ResourceInstanceObject, *providers.Deferred, tfdiags.Diagnostics) {
+func (n *NodeAbstractResourceInstance) refresh(ctx EvalContext, deposedKey states.DeposedKey, state *states.ResourceInstanceObject, deferralAllowed bool, refreshOnly bool) (*states.ResourceInstanceObject, *providers.Deferred, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 	var deferred *providers.Deferred
 	absAddr := n.Addr
@@ -676,6 +676,7 @@ func (n *NodeAbstractResourceInstance) refresh(ctx EvalContext, deposedKey state
 			ProviderMeta:       metaConfigVal,
 			ClientCapabilities: ctx.ClientCapabilities(),
 			CurrentIdentity:    state.Identity,
+			RefreshOnly:        refreshOnly,
 		})
 
 		// If we don't support deferrals, but the provider reports a deferral and does not
@@ -2647,13 +2648,14 @@ func (n *NodeAbstractResourceInstance) apply(
 		}
 	} else {
 		resp = provider.ApplyResourceChange(providers.ApplyResourceChangeRequest{
-			TypeName:        n.Addr.Resource.Resource.Type,
-			PriorState:      unmarkedBefore,
-			Config:          unmarkedConfigVal,
-			PlannedState:    unmarkedAfter,
-			PlannedPrivate:  change.Private,
-			ProviderMeta:    metaConfigVal,
-			PlannedIdentity: change.AfterIdentity,
+			TypeName:           n.Addr.Resource.Resource.Type,
+			PriorState:         unmarkedBefore,
+			Config:             unmarkedConfigVal,
+			PlannedState:       unmarkedAfter,
+			PlannedPrivate:     change.Private,
+			ProviderMeta:       metaConfigVal,
+			PlannedIdentity:    change.AfterIdentity,
+			ClientCapabilities: ctx.ClientCapabilities(),
 		})
 
 		if !resp.NewIdentity.IsNull() {
@@ -275,7 +275,7 @@ func (impl *ephemeralResourceInstImpl) Close(ctx context.Context) tfdiags.Diagno
 }
 
 // Renew implements ephemeral.ResourceInstance.
-func (impl *ephemeralResourceInstImpl) Renew(ctx context.Context, req providers.EphemeralRenew) (nextRenew *providers.EphemeralRenew, diags tfdiags.Diagnostics) {
+func (impl *ephemeralResourceInstImpl) Renew(ctx context.Context, req providers.EphemeralRenew) (nextRenew *providers.EphemeralRenew, deferred *providers.Deferred, diags tfdiags.Diagnostics) {
 	log.Printf("[TRACE] ephemeralResourceInstImpl: renewing %s", impl.addr)
 
 	rId := HookResourceIdentity{
@@ -292,6 +292,9 @@ func (impl *ephemeralResourceInstImpl) Renew(ctx context.Context, req providers.
 	impl.hook(func(h Hook) (HookAction, error) {
 		return h.PostEphemeralOp(rId, plans.Renew, resp.Diagnostics.Err())
 	})
+	if resp.Deferred != nil {
+		return nil, resp.Deferred, resp.Diagnostics
+	}
 	if !resp.RenewAt.IsZero() {
 		nextRenew = &providers.EphemeralRenew{
 			RenewAt: resp.RenewAt,
@@ -299,5 +302,5 @@ func (impl *ephemeralResourceInstImpl) Renew(ctx context.Context, req providers.
 		}
 	}
 
-	return nextRenew, resp.Diagnostics
+	return nextRenew, nil, resp.Diagnostics
 }
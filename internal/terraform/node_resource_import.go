@@ -259,7 +259,7 @@ func (n *graphNodeImportStateSub) Execute(ctx EvalContext, op walkOperation) (di
 			ResolvedProvider: n.ResolvedProvider,
 		},
 	}
-	state, deferred, refreshDiags := riNode.refresh(ctx, states.NotDeposed, state, false)
+	state, deferred, refreshDiags := riNode.refresh(ctx, states.NotDeposed, state, false, false)
 	diags = diags.Append(refreshDiags)
 	if diags.HasErrors() {
 		return diags
@@ -215,6 +215,36 @@ func (diags Diagnostics) HasWarnings() bool {
 	return false
 }
 
+// Counts returns the number of warning-severity and error-severity
+// diagnostics in the list, so a caller that just wants a summary doesn't
+// need to walk the whole list itself. It agrees with HasWarnings and
+// HasErrors: warnings is nonzero exactly when HasWarnings is true, and
+// likewise for errors and HasErrors.
+func (diags Diagnostics) Counts() (warnings, errors int) {
+	for _, diag := range diags {
+		switch diag.Severity() {
+		case Warning:
+			warnings++
+		case Error:
+			errors++
+		}
+	}
+	return warnings, errors
+}
+
+// WarningCount returns the number of warning-severity diagnostics in the
+// list.
+func (diags Diagnostics) WarningCount() int {
+	warnings, _ := diags.Counts()
+	return warnings
+}
+
+// ErrorCount returns the number of error-severity diagnostics in the list.
+func (diags Diagnostics) ErrorCount() int {
+	_, errors := diags.Counts()
+	return errors
+}
+
 // ForRPC returns a version of the receiver that has been simplified so that
 // it is friendly to RPC protocols.
 //
@@ -318,6 +318,38 @@ func TestDiagnosticsErr(t *testing.T) {
 	})
 }
 
+func TestDiagnosticsCounts(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		var diags Diagnostics
+		warnings, errs := diags.Counts()
+		if warnings != 0 || errs != 0 {
+			t.Errorf("got (%d, %d); want (0, 0)", warnings, errs)
+		}
+	})
+	t.Run("warnings and errors", func(t *testing.T) {
+		var diags Diagnostics
+		diags = diags.Append(errors.New("didn't work"))
+		diags = diags.Append(SimpleWarning("might not work"))
+		diags = diags.Append(SimpleWarning("also might not work"))
+		warnings, errs := diags.Counts()
+		if warnings != 2 || errs != 1 {
+			t.Errorf("got (%d, %d); want (2, 1)", warnings, errs)
+		}
+		if got, want := diags.WarningCount(), warnings; got != want {
+			t.Errorf("WarningCount() = %d; want %d", got, want)
+		}
+		if got, want := diags.ErrorCount(), errs; got != want {
+			t.Errorf("ErrorCount() = %d; want %d", got, want)
+		}
+		if diags.HasWarnings() != (warnings > 0) {
+			t.Error("HasWarnings() disagrees with Counts()")
+		}
+		if diags.HasErrors() != (errs > 0) {
+			t.Error("HasErrors() disagrees with Counts()")
+		}
+	})
+}
+
 func TestDiagnosticsErrWithWarnings(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
 		var diags Diagnostics
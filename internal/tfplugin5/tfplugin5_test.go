@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfplugin5
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// TestApplyResourceChange_roundTrip guards against new fields being added to
+// the generated structs without also updating the embedded file descriptor
+// (rawDesc) that proto.Marshal/Unmarshal actually use to walk the wire
+// format: a field with a Go struct tag but no matching descriptor entry is
+// silently dropped during (de)serialization instead of causing a compile or
+// test failure.
+func TestApplyResourceChange_roundTrip(t *testing.T) {
+	req := &ApplyResourceChange_Request{
+		TypeName:       "test_thing",
+		IdempotencyKey: "retry-once",
+	}
+	data, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatalf("Marshal request: %s", err)
+	}
+	var gotReq ApplyResourceChange_Request
+	if err := proto.Unmarshal(data, &gotReq); err != nil {
+		t.Fatalf("Unmarshal request: %s", err)
+	}
+	if gotReq.IdempotencyKey != req.IdempotencyKey {
+		t.Fatalf("wrong idempotency_key after round trip: got %q, want %q", gotReq.IdempotencyKey, req.IdempotencyKey)
+	}
+
+	resp := &ApplyResourceChange_Response{
+		Notices: []string{"resource will take ~5 min to become active"},
+	}
+	data, err = proto.Marshal(resp)
+	if err != nil {
+		t.Fatalf("Marshal response: %s", err)
+	}
+	var gotResp ApplyResourceChange_Response
+	if err := proto.Unmarshal(data, &gotResp); err != nil {
+		t.Fatalf("Unmarshal response: %s", err)
+	}
+	if len(gotResp.Notices) != 1 || gotResp.Notices[0] != resp.Notices[0] {
+		t.Fatalf("wrong notices after round trip: got %#v, want %#v", gotResp.Notices, resp.Notices)
+	}
+}
+
+func TestPlanResourceChange_Response_sensitivePathsRoundTrip(t *testing.T) {
+	resp := &PlanResourceChange_Response{
+		SensitivePaths: []*AttributePath{
+			{
+				Steps: []*AttributePath_Step{
+					{Selector: &AttributePath_Step_AttributeName{AttributeName: "password"}},
+				},
+			},
+		},
+	}
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		t.Fatalf("Marshal: %s", err)
+	}
+	var got PlanResourceChange_Response
+	if err := proto.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: %s", err)
+	}
+	if len(got.SensitivePaths) != 1 {
+		t.Fatalf("expected 1 sensitive path after round trip, got %d", len(got.SensitivePaths))
+	}
+	if len(got.SensitivePaths[0].Steps) != 1 {
+		t.Fatalf("expected 1 step in sensitive path after round trip, got %d", len(got.SensitivePaths[0].Steps))
+	}
+	name, ok := got.SensitivePaths[0].Steps[0].Selector.(*AttributePath_Step_AttributeName)
+	if !ok || name.AttributeName != "password" {
+		t.Fatalf("wrong sensitive path step after round trip: %#v", got.SensitivePaths[0].Steps[0].Selector)
+	}
+}
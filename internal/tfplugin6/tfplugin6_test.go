@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfplugin6
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// TestReadResource_Request_refreshOnlyRoundTrip guards against new fields
+// being added to the generated structs without also updating the embedded
+// file descriptor (rawDesc) that proto.Marshal/Unmarshal actually use to
+// walk the wire format: a field with a Go struct tag but no matching
+// descriptor entry is silently dropped during (de)serialization instead of
+// causing a compile or test failure.
+func TestReadResource_Request_refreshOnlyRoundTrip(t *testing.T) {
+	req := &ReadResource_Request{
+		TypeName:    "test_thing",
+		RefreshOnly: true,
+	}
+	data, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatalf("Marshal: %s", err)
+	}
+	var got ReadResource_Request
+	if err := proto.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: %s", err)
+	}
+	if !got.RefreshOnly {
+		t.Fatal("expected refresh_only to survive round trip")
+	}
+}